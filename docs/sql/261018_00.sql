@@ -0,0 +1,5 @@
+-- [DDL] Link every occurrence of a periodic task back to the first task of its series
+ALTER TABLE `task` ADD `fk_series_id` INT COMMENT 'Foreign Key To the first Task of the periodic series' AFTER `fk_category_id`;
+
+-- [DDL] One occurrence per due time of the series, so two concurrent completions can not spawn the same occurrence twice
+ALTER TABLE `task` ADD UNIQUE INDEX `uk_task_fk_series_id_due_time` (`fk_series_id`, `due_time`);
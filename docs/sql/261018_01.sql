@@ -0,0 +1,2 @@
+-- [DDL] Add RFC 5545 recurrence rule to task
+ALTER TABLE `task` ADD `rrule` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'RFC 5545 recurrence rule, e.g. FREQ=MONTHLY;BYDAY=2TU' AFTER `periodic`;
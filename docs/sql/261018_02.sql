@@ -0,0 +1,4 @@
+-- [DDL] Add parent task to accomodate subtask
+ALTER TABLE `task` ADD `fk_parent_task_id` INT COMMENT 'Foreign Key To parent Task Id' AFTER `fk_series_id`;
+ALTER TABLE `task` ADD `auto_complete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT 'Mark the task as done once all of its subtasks are done' AFTER `due_time`;
+ALTER TABLE `task` ADD INDEX `idx_task_fk_parent_task_id` (`fk_parent_task_id`);
@@ -0,0 +1,21 @@
+-- [DDL] Create new table for Task Dependency
+DROP TABLE IF EXISTS `task_dependency`;
+CREATE TABLE IF NOT EXISTS `task_dependency` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To the blocked Task Id',
+    `fk_blocker_task_id` INT NOT NULL COMMENT 'Foreign Key To the blocker Task Id',
+    `active_blocker_task_id` INT AS (IF(`status` = 1, `fk_blocker_task_id`, NULL)) STORED COMMENT 'Only set on the active dependency, so a removed blocker can be added again',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_task_dependency_fk_task_id` (`fk_task_id`),
+    INDEX `idx_task_dependency_fk_blocker_task_id` (`fk_blocker_task_id`),
+    UNIQUE INDEX `idx_task_dependency_fk_task_id_blocker` (`fk_task_id`, `active_blocker_task_id`)
+) ENGINE = INNODB COMMENT='Task Dependency Table';
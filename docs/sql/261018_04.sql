@@ -0,0 +1,15 @@
+-- [DDL] Create new table for Task Status History
+DROP TABLE IF EXISTS `task_status_history`;
+CREATE TABLE IF NOT EXISTS `task_status_history` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `from_status` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'todo, ongoing, done, empty when the task is created',
+    `to_status` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'todo, ongoing, done',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_task_status_history_fk_task_id` (`fk_task_id`)
+) ENGINE = INNODB COMMENT='Task Status History Table';
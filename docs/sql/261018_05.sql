@@ -0,0 +1,19 @@
+-- [DDL] Create new table for Task Comment
+DROP TABLE IF EXISTS `task_comment`;
+CREATE TABLE IF NOT EXISTS `task_comment` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the author of the comment',
+    `content` TEXT NOT NULL,
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_task_comment_fk_task_id` (`fk_task_id`)
+) ENGINE = INNODB COMMENT='Task Comment Table';
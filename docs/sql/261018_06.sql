@@ -0,0 +1,23 @@
+-- [DDL] Create new table for Task Attachment
+DROP TABLE IF EXISTS `task_attachment`;
+CREATE TABLE IF NOT EXISTS `task_attachment` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the uploader of the file',
+    `file_name` VARCHAR(255) NOT NULL DEFAULT '',
+    `mime_type` VARCHAR(255) NOT NULL DEFAULT '',
+    `size` BIGINT NOT NULL DEFAULT 0 COMMENT 'File size in bytes',
+    `checksum` CHAR(64) NOT NULL DEFAULT '' COMMENT 'SHA-256 of the file content in hex',
+    `storage_key` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'Location of the file in the storage backend',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_task_attachment_fk_task_id` (`fk_task_id`)
+) ENGINE = INNODB COMMENT='Task Attachment Table';
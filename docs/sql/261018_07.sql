@@ -0,0 +1,38 @@
+-- [DDL] Create new table for Tag
+DROP TABLE IF EXISTS `tag`;
+CREATE TABLE IF NOT EXISTS `tag` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the owner of the tag',
+    `name` VARCHAR(255) NOT NULL DEFAULT '',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_tag_fk_user_id_name` (`fk_user_id`, `name`)
+) ENGINE = INNODB COMMENT='Tag Table';
+
+-- [DDL] Create new table for Task Tag
+DROP TABLE IF EXISTS `task_tag`;
+CREATE TABLE IF NOT EXISTS `task_tag` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `fk_tag_id` INT NOT NULL COMMENT 'Foreign Key To Tag Id',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_task_tag_fk_task_id` (`fk_task_id`),
+    INDEX `idx_task_tag_fk_tag_id` (`fk_tag_id`)
+) ENGINE = INNODB COMMENT='Task Tag Table';
@@ -0,0 +1,7 @@
+-- [DDL] Add description to task
+ALTER TABLE `task` ADD `description` TEXT NOT NULL AFTER `title`;
+
+-- [DDL] Add FULLTEXT indexes for the search
+ALTER TABLE `task` ADD FULLTEXT INDEX `ftx_task_title_description` (`title`, `description`);
+ALTER TABLE `task_comment` ADD FULLTEXT INDEX `ftx_task_comment_content` (`content`);
+ALTER TABLE `category` ADD FULLTEXT INDEX `ftx_category_name` (`name`);
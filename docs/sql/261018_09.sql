@@ -0,0 +1,30 @@
+-- [DDL] Create new table for Task Reminder
+DROP TABLE IF EXISTS `task_reminder`;
+CREATE TABLE IF NOT EXISTS `task_reminder` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the recipient of the reminder',
+    `kind` VARCHAR(16) NOT NULL DEFAULT 'before' COMMENT 'before: offset_minutes before due_time, at: time_of_day on the due day',
+    `offset_minutes` INT NOT NULL DEFAULT 0,
+    `time_of_day` VARCHAR(5) NOT NULL DEFAULT '' COMMENT 'HH:MM, used by at kind',
+    `timezone` VARCHAR(64) NOT NULL DEFAULT 'UTC' COMMENT 'IANA time zone of time_of_day, used by at kind',
+    `remind_at` TIMESTAMP NULL COMMENT 'Computed from the due_time of the task, NULL when the task has no due_time',
+    `sent_at` TIMESTAMP NULL,
+    `claim_token` VARCHAR(64) NOT NULL DEFAULT '' COMMENT 'Worker which is currently sending the reminder',
+    `claimed_at` TIMESTAMP NULL,
+    `attempts` INT NOT NULL DEFAULT 0,
+    `last_error` VARCHAR(255) NOT NULL DEFAULT '',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_task_reminder_fk_task_id` (`fk_task_id`),
+    INDEX `idx_task_reminder_remind_at` (`sent_at`, `remind_at`),
+    INDEX `idx_task_reminder_claim_token` (`claim_token`)
+) ENGINE = INNODB COMMENT='Task Reminder Table';
@@ -0,0 +1,3 @@
+-- [DDL] Add calendar feed token to user, only the SHA-256 of the token is stored
+ALTER TABLE `user` ADD `calendar_token` CHAR(64) COMMENT 'SHA-256 of the secret token of the calendar feed, NULL means the feed is disabled' AFTER `display_name`;
+ALTER TABLE `user` ADD UNIQUE INDEX `idx_user_calendar_token` (`calendar_token`);
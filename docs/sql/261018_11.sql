@@ -0,0 +1,25 @@
+-- [DDL] Create new table for Time Entry
+DROP TABLE IF EXISTS `time_entry`;
+CREATE TABLE IF NOT EXISTS `time_entry` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the user who spent the time',
+    `started_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `ended_at` TIMESTAMP NULL COMMENT 'NULL while the timer is running',
+    `duration_seconds` INT NOT NULL DEFAULT 0 COMMENT 'Filled once the entry is ended',
+    `note` VARCHAR(255) NOT NULL DEFAULT '',
+    `running_user_id` INT AS (IF(`ended_at` IS NULL AND `status` = 1, `fk_user_id`, NULL)) STORED COMMENT 'Only set on the running timer, so a user can not have two running timers',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_time_entry_fk_task_id` (`fk_task_id`),
+    INDEX `idx_time_entry_fk_user_id` (`fk_user_id`, `started_at`),
+    UNIQUE INDEX `idx_time_entry_running_user_id` (`running_user_id`)
+) ENGINE = INNODB COMMENT='Time Entry Table';
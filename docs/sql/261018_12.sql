@@ -0,0 +1,29 @@
+-- [DDL] Create new table for Focus Session
+DROP TABLE IF EXISTS `focus_session`;
+CREATE TABLE IF NOT EXISTS `focus_session` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id',
+    `work_minutes` INT NOT NULL DEFAULT 25,
+    `break_minutes` INT NOT NULL DEFAULT 5,
+    `state` VARCHAR(16) NOT NULL DEFAULT 'running' COMMENT 'running, completed or interrupted',
+    `started_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `ends_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'End of the work part, started_at + work_minutes',
+    `ended_at` TIMESTAMP NULL COMMENT 'When the session was completed or interrupted',
+    `focus_seconds` INT NOT NULL DEFAULT 0 COMMENT 'Time actually focused, filled once the session is ended',
+    `interrupt_reason` VARCHAR(255) NOT NULL DEFAULT '',
+    `running_user_id` INT AS (IF(`state` = 'running' AND `status` = 1, `fk_user_id`, NULL)) STORED COMMENT 'Only set on the running session, so a user can not focus on two tasks at once',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_focus_session_fk_task_id` (`fk_task_id`),
+    INDEX `idx_focus_session_fk_user_id` (`fk_user_id`, `started_at`),
+    UNIQUE INDEX `idx_focus_session_running_user_id` (`running_user_id`)
+) ENGINE = INNODB COMMENT='Focus Session Table';
@@ -0,0 +1,44 @@
+-- [DDL] Create new table for User Progress
+DROP TABLE IF EXISTS `user_progress`;
+CREATE TABLE IF NOT EXISTS `user_progress` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id',
+    `xp` INT NOT NULL DEFAULT 0 COMMENT 'Total experience points, the level is computed from it',
+    `completed_tasks` INT NOT NULL DEFAULT 0 COMMENT 'Number of the tasks which were awarded',
+    `current_streak` INT NOT NULL DEFAULT 0 COMMENT 'Consecutive days with at least one completed task, up to last_completed_on',
+    `longest_streak` INT NOT NULL DEFAULT 0,
+    `last_completed_on` DATE NULL COMMENT 'Day of the last completed task in the streak time zone',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_user_progress_fk_user_id` (`fk_user_id`)
+) ENGINE = INNODB COMMENT='User Progress Table';
+
+-- [DDL] Create new table for XP Award
+DROP TABLE IF EXISTS `xp_award`;
+CREATE TABLE IF NOT EXISTS `xp_award` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the user who completed the task',
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `xp` INT NOT NULL DEFAULT 0,
+    `on_time` TINYINT(1) NOT NULL DEFAULT 0 COMMENT 'The task was done before its due time',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_xp_award_fk_user_id` (`fk_user_id`),
+    UNIQUE INDEX `idx_xp_award_fk_task_id` (`fk_task_id`) COMMENT 'A task is only awarded once, reopening and finishing it again does not give more XP'
+) ENGINE = INNODB COMMENT='XP Award Table';
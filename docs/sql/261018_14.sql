@@ -0,0 +1,54 @@
+-- [DDL] Create new table for Achievement
+DROP TABLE IF EXISTS `achievement`;
+CREATE TABLE IF NOT EXISTS `achievement` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `code` VARCHAR(64) NOT NULL COMMENT 'Unique key of the achievement',
+    `name` VARCHAR(255) NOT NULL,
+    `description` VARCHAR(255) NOT NULL DEFAULT '',
+    `icon` VARCHAR(255) NOT NULL DEFAULT '',
+    `rule` VARCHAR(32) NOT NULL COMMENT 'tasks_done, streak_days, level, xp, overdue_cleared, focus_sessions or focus_minutes',
+    `threshold` INT NOT NULL DEFAULT 1 COMMENT 'Metric of the rule needed to unlock the achievement',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_achievement_code` (`code`),
+    INDEX `idx_achievement_rule` (`rule`)
+) ENGINE = INNODB COMMENT='Achievement Table';
+
+-- [DDL] Create new table for User Achievement
+DROP TABLE IF EXISTS `user_achievement`;
+CREATE TABLE IF NOT EXISTS `user_achievement` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id',
+    `fk_achievement_id` INT NOT NULL COMMENT 'Foreign Key To Achievement Id',
+    `unlocked_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_user_achievement_fk_user_id` (`fk_user_id`, `fk_achievement_id`)
+) ENGINE = INNODB COMMENT='User Achievement Table';
+
+INSERT INTO `achievement` (`code`, `name`, `description`, `rule`, `threshold`) VALUES
+('first_task', 'First Step', 'Finish your first task', 'tasks_done', 1),
+('tasks_10', 'Getting Things Done', 'Finish 10 tasks', 'tasks_done', 10),
+('tasks_100', 'Centurion', 'Finish 100 tasks', 'tasks_done', 100),
+('streak_7', 'On Fire', 'Finish a task 7 days in a row', 'streak_days', 7),
+('level_5', 'Rising Star', 'Reach level 5', 'level', 5),
+('overdue_cleared', 'Clean Slate', 'Finish an overdue task and leave no overdue task behind', 'overdue_cleared', 1),
+('first_focus', 'In The Zone', 'Complete your first focus session', 'focus_sessions', 1),
+('focus_600', 'Deep Worker', 'Focus for 10 hours in total', 'focus_minutes', 600)
+;
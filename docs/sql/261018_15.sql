@@ -0,0 +1,2 @@
+-- [DDL] Add leaderboard privacy setting to user, the user who opted out is not shown on any leaderboard
+ALTER TABLE `user` ADD `leaderboard_opt_out` TINYINT(1) NOT NULL DEFAULT 0 COMMENT 'The user is hidden from the leaderboards' AFTER `calendar_token`;
@@ -0,0 +1,20 @@
+-- [DDL] Create new table for Habit Check In, a periodic task is tracked as a habit without spawning a new task per day
+DROP TABLE IF EXISTS `habit_checkin`;
+CREATE TABLE IF NOT EXISTS `habit_checkin` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id, the first task of the series',
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the user who checked in',
+    `checkin_date` DATE NOT NULL COMMENT 'Day of the check in in the time zone of the user',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_habit_checkin_fk_user_id` (`fk_user_id`),
+    UNIQUE INDEX `idx_habit_checkin_fk_task_id_checkin_date` (`fk_task_id`, `checkin_date`) COMMENT 'A habit is checked in once per day, the deleted check in is restored'
+) ENGINE = INNODB COMMENT='Habit Check In Table';
@@ -0,0 +1,3 @@
+-- [DDL] Add assignee to task, the assignee can read and update the task next to its owner
+ALTER TABLE `task` ADD `fk_assignee_id` INT COMMENT 'Foreign Key To User Id, the user the task is assigned to' AFTER `fk_user_id`;
+ALTER TABLE `task` ADD INDEX `idx_task_fk_assignee_id` (`fk_assignee_id`);
@@ -0,0 +1,70 @@
+-- [DDL] Create new table for Workspace, a team which shares its tasks and categories
+DROP TABLE IF EXISTS `workspace`;
+CREATE TABLE IF NOT EXISTS `workspace` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_owner_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the owner of the workspace',
+    `name` VARCHAR(255) NOT NULL,
+    `description` TEXT,
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_workspace_fk_owner_id` (`fk_owner_id`)
+) ENGINE = INNODB COMMENT='Workspace Table';
+
+-- [DDL] Create new table for Workspace Member, the role is per workspace
+DROP TABLE IF EXISTS `workspace_member`;
+CREATE TABLE IF NOT EXISTS `workspace_member` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_workspace_id` INT NOT NULL COMMENT 'Foreign Key To Workspace Id',
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id',
+    `workspace_role` VARCHAR(16) NOT NULL DEFAULT 'member' COMMENT 'owner, admin or member',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_workspace_member_fk_user_id` (`fk_user_id`),
+    UNIQUE INDEX `idx_workspace_member_fk_workspace_id_fk_user_id` (`fk_workspace_id`, `fk_user_id`) COMMENT 'The member who left and joined again is restored'
+) ENGINE = INNODB COMMENT='Workspace Member Table';
+
+-- [DDL] Create new table for Workspace Invitation, the invited email join the workspace once it is accepted
+DROP TABLE IF EXISTS `workspace_invitation`;
+CREATE TABLE IF NOT EXISTS `workspace_invitation` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_workspace_id` INT NOT NULL COMMENT 'Foreign Key To Workspace Id',
+    `fk_inviter_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the user who sent the invitation',
+    `email` VARCHAR(255) NOT NULL COMMENT 'Email of the invited user',
+    `workspace_role` VARCHAR(16) NOT NULL DEFAULT 'member' COMMENT 'admin or member, the role of the user once the invitation is accepted',
+    `invitation_status` VARCHAR(16) NOT NULL DEFAULT 'pending' COMMENT 'pending, accepted, declined or revoked',
+    `responded_at` TIMESTAMP NULL COMMENT 'Time the invitation was accepted, declined or revoked',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_workspace_invitation_fk_workspace_id` (`fk_workspace_id`),
+    INDEX `idx_workspace_invitation_email` (`email`)
+) ENGINE = INNODB COMMENT='Workspace Invitation Table';
+
+-- [DDL] Scope task and category to a workspace, the personal task and the global category have no workspace
+ALTER TABLE `task` ADD `fk_workspace_id` INT COMMENT 'Foreign Key To Workspace Id' AFTER `fk_assignee_id`;
+ALTER TABLE `task` ADD INDEX `idx_task_fk_workspace_id` (`fk_workspace_id`);
+ALTER TABLE `category` ADD `fk_workspace_id` INT COMMENT 'Foreign Key To Workspace Id' AFTER `id`;
+ALTER TABLE `category` ADD INDEX `idx_category_fk_workspace_id` (`fk_workspace_id`);
@@ -0,0 +1,65 @@
+-- [DDL] Create new table for Board, a kanban view of the personal tasks of its owner or of the tasks of its workspace
+DROP TABLE IF EXISTS `board`;
+CREATE TABLE IF NOT EXISTS `board` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_user_id` INT NOT NULL COMMENT 'Foreign Key To User Id, the owner of the board',
+    `fk_workspace_id` INT COMMENT 'Foreign Key To Workspace Id, empty on the personal board',
+    `name` VARCHAR(255) NOT NULL,
+    `description` TEXT,
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_board_fk_user_id` (`fk_user_id`),
+    INDEX `idx_board_fk_workspace_id` (`fk_workspace_id`)
+) ENGINE = INNODB COMMENT='Board Table';
+
+-- [DDL] Create new table for Board Column, a column is either mapped to a task status or a custom lane
+DROP TABLE IF EXISTS `board_column`;
+CREATE TABLE IF NOT EXISTS `board_column` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_board_id` INT NOT NULL COMMENT 'Foreign Key To Board Id',
+    `name` VARCHAR(255) NOT NULL,
+    `column_type` VARCHAR(16) NOT NULL DEFAULT 'custom' COMMENT 'status or custom',
+    `task_status` VARCHAR(16) COMMENT 'todo, ongoing or done, the status of the tasks in the status column',
+    `column_order` INT NOT NULL DEFAULT '0' COMMENT 'Order of the column on the board, ascending',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_board_column_fk_board_id` (`fk_board_id`)
+) ENGINE = INNODB COMMENT='Board Column Table';
+
+-- [DDL] Create new table for Board Task, the column and the position of the task which was moved on the board
+DROP TABLE IF EXISTS `board_task`;
+CREATE TABLE IF NOT EXISTS `board_task` (
+    `id` INT NOT NULL AUTO_INCREMENT,
+    `fk_board_id` INT NOT NULL COMMENT 'Foreign Key To Board Id',
+    `fk_board_column_id` INT NOT NULL COMMENT 'Foreign Key To Board Column Id',
+    `fk_task_id` INT NOT NULL COMMENT 'Foreign Key To Task Id',
+    `position` VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' COMMENT 'Rank key of the task in the column, the task which was never moved is ordered by the rank key of the task',
+
+    -- Utility columns
+    `status` SMALLINT NOT NULL DEFAULT '1',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `created_by` VARCHAR(255),
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `updated_by` VARCHAR(255),
+    `deleted_at`TIMESTAMP,
+    `deleted_by` VARCHAR(255),
+    PRIMARY KEY (`id`),
+    INDEX `idx_board_task_fk_task_id` (`fk_task_id`),
+    UNIQUE INDEX `idx_board_task_fk_board_id_fk_task_id` (`fk_board_id`, `fk_task_id`) COMMENT 'A task has one place on the board'
+) ENGINE = INNODB COMMENT='Board Task Table';
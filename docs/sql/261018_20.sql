@@ -0,0 +1,8 @@
+-- [DDL] Add position to task, a fractional rank key so moving a task only changes the key of that task
+ALTER TABLE `task` ADD `position` VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' COMMENT 'Rank key of the task, the tasks are ordered by comparing the keys byte by byte' AFTER `auto_complete`;
+
+-- [DML] Give the existing tasks the keys in the order of their id. The integer part with head f has 6 digits, which
+-- fits every INT id in base 36 (the largest is ZIK0ZJ), so no id is cut by LPAD
+UPDATE `task` SET `position` = CONCAT('f', LPAD(CONV(`id`, 10, 36), 6, '0'));
+
+ALTER TABLE `task` ADD INDEX `idx_task_position` (`position`);
@@ -6,6 +6,8 @@ require (
 	github.com/adiatma85/dark-gin-swagger v1.1.0
 	github.com/adiatma85/own-go-sdk v0.1.12
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
+	github.com/gabriel-vasile/mimetype v1.4.2
+	github.com/go-sql-driver/mysql v1.7.1
 	github.com/swaggo/files v1.0.1
 	github.com/swaggo/swag v1.16.2
 	golang.org/x/crypto v0.21.0
@@ -18,7 +20,6 @@ require (
 	github.com/bytedance/sonic v1.10.1 // indirect
 	github.com/chenzhuoyu/base64x v0.0.0-20230717121745-296ad89f973d // indirect
 	github.com/chenzhuoyu/iasm v0.9.0 // indirect
-	github.com/gabriel-vasile/mimetype v1.4.2 // indirect
 	github.com/gin-contrib/sse v0.1.0 // indirect
 	github.com/go-openapi/jsonpointer v0.19.5 // indirect
 	github.com/go-openapi/jsonreference v0.19.6 // indirect
@@ -49,8 +50,7 @@ require (
 	github.com/fsnotify/fsnotify v1.6.0 // indirect
 	github.com/gin-contrib/cors v1.5.0
 	github.com/gin-gonic/gin v1.9.1
-	github.com/go-redis/redis/v8 v8.11.5 // indirect
-	github.com/go-sql-driver/mysql v1.7.1 // indirect
+	github.com/go-redis/redis/v8 v8.11.5
 	github.com/golang/protobuf v1.5.3 // indirect
 	github.com/google/uuid v1.4.0
 	github.com/hashicorp/hcl v1.0.0 // indirect
@@ -0,0 +1,77 @@
+package achievement
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, achievementParam entity.CreateAchievementParam) (entity.Achievement, error)
+	Get(ctx context.Context, params entity.AchievementParam) (entity.Achievement, error)
+	GetList(ctx context.Context, params entity.AchievementParam) ([]entity.Achievement, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateAchievementParam, selectParam entity.AchievementParam) error
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type achievement struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	a := &achievement{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return a
+}
+
+func (a *achievement) Create(ctx context.Context, achievementParam entity.CreateAchievementParam) (entity.Achievement, error) {
+	achievement := entity.Achievement{}
+
+	tx, err := a.db.Leader().BeginTx(ctx, "txcAchievement", sql.TxOptions{})
+	if err != nil {
+		return achievement, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, achievement, err = a.createSQLAchievement(tx, achievementParam)
+	if err != nil {
+		return achievement, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return achievement, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return a.Get(ctx, entity.AchievementParam{
+		ID: null.Int64From(achievement.ID),
+	})
+}
+
+func (a *achievement) Get(ctx context.Context, params entity.AchievementParam) (entity.Achievement, error) {
+	return a.getSQLAchievement(ctx, params)
+}
+
+func (a *achievement) GetList(ctx context.Context, params entity.AchievementParam) ([]entity.Achievement, *entity.Pagination, error) {
+	return a.getSQLAchievementList(ctx, params)
+}
+
+func (a *achievement) Update(ctx context.Context, updateParam entity.UpdateAchievementParam, selectParam entity.AchievementParam) error {
+	return a.updateSQLAchievement(ctx, updateParam, selectParam)
+}
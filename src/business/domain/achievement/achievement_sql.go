@@ -0,0 +1,122 @@
+package achievement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (a *achievement) createSQLAchievement(tx sql.CommandTx, v entity.CreateAchievementParam) (sql.CommandTx, entity.Achievement, error) {
+	achievement := entity.Achievement{}
+
+	res, err := tx.NamedExec("iCreateAchievement", createAchievement, v)
+	if err != nil {
+		return tx, achievement, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, achievement, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, achievement, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	achievement.ID = lastID
+
+	return tx, achievement, nil
+}
+
+func (a *achievement) getSQLAchievement(ctx context.Context, params entity.AchievementParam) (entity.Achievement, error) {
+	achievement := entity.Achievement{}
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return achievement, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := a.db.Follower().QueryRow(ctx, "rAchievementByID", getAchievement+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return achievement, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return achievement, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&achievement); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return achievement, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return achievement, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return achievement, nil
+}
+
+func (a *achievement) getSQLAchievementList(ctx context.Context, params entity.AchievementParam) ([]entity.Achievement, *entity.Pagination, error) {
+	achievements := []entity.Achievement{}
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return achievements, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := a.db.Follower().Query(ctx, "rListAchievement", getAchievement+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return achievements, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.Achievement{}
+		if err := rows.StructScan(&temp); err != nil {
+			a.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		achievements = append(achievements, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(achievements)),
+	}
+
+	if len(achievements) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := a.db.Follower().Get(ctx, "cAchievement", readAchievementCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return achievements, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return achievements, &pg, nil
+}
+
+func (a *achievement) updateSQLAchievement(ctx context.Context, updateParam entity.UpdateAchievementParam, selectParam entity.AchievementParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("update achievement by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = a.db.Leader().Exec(ctx, "uAchievement", updateAchievement+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("successfully updated achievement: %v", updateParam))
+
+	return nil
+}
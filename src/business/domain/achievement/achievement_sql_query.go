@@ -0,0 +1,34 @@
+package achievement
+
+const (
+	createAchievement = `
+	INSERT INTO achievement (code, name, description, icon, rule, threshold, created_by, updated_by)
+	    VALUES (:code, :name, :description, :icon, :rule, :threshold, :created_by, :updated_by)`
+
+	getAchievement = `
+		SELECT
+			id,
+			code,
+			name,
+			description,
+			icon,
+			rule,
+			threshold,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			achievement`
+
+	updateAchievement = `
+	UPDATE
+		achievement`
+
+	readAchievementCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			achievement`
+)
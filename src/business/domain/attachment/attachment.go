@@ -0,0 +1,77 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, attachmentParam entity.CreateTaskAttachmentParam) (entity.TaskAttachment, error)
+	Get(ctx context.Context, params entity.TaskAttachmentParam) (entity.TaskAttachment, error)
+	GetList(ctx context.Context, params entity.TaskAttachmentParam) ([]entity.TaskAttachment, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTaskAttachmentParam, selectParam entity.TaskAttachmentParam) error
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type attachment struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	a := &attachment{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return a
+}
+
+func (a *attachment) Create(ctx context.Context, attachmentParam entity.CreateTaskAttachmentParam) (entity.TaskAttachment, error) {
+	attachment := entity.TaskAttachment{}
+
+	tx, err := a.db.Leader().BeginTx(ctx, "txcAttachment", sql.TxOptions{})
+	if err != nil {
+		return attachment, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, attachment, err = a.createSQLAttachment(tx, attachmentParam)
+	if err != nil {
+		return attachment, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return attachment, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return a.Get(ctx, entity.TaskAttachmentParam{
+		ID: null.Int64From(attachment.ID),
+	})
+}
+
+func (a *attachment) Get(ctx context.Context, params entity.TaskAttachmentParam) (entity.TaskAttachment, error) {
+	return a.getSQLAttachment(ctx, params)
+}
+
+func (a *attachment) GetList(ctx context.Context, params entity.TaskAttachmentParam) ([]entity.TaskAttachment, *entity.Pagination, error) {
+	return a.getSQLAttachmentList(ctx, params)
+}
+
+func (a *attachment) Update(ctx context.Context, updateParam entity.UpdateTaskAttachmentParam, selectParam entity.TaskAttachmentParam) error {
+	return a.updateSQLAttachment(ctx, updateParam, selectParam)
+}
@@ -0,0 +1,122 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (a *attachment) createSQLAttachment(tx sql.CommandTx, v entity.CreateTaskAttachmentParam) (sql.CommandTx, entity.TaskAttachment, error) {
+	attachment := entity.TaskAttachment{}
+
+	res, err := tx.NamedExec("iCreateAttachment", createAttachment, v)
+	if err != nil {
+		return tx, attachment, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, attachment, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, attachment, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	attachment.ID = lastID
+
+	return tx, attachment, nil
+}
+
+func (a *attachment) getSQLAttachment(ctx context.Context, params entity.TaskAttachmentParam) (entity.TaskAttachment, error) {
+	attachment := entity.TaskAttachment{}
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return attachment, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := a.db.Follower().QueryRow(ctx, "rAttachmentByID", getAttachment+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return attachment, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return attachment, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&attachment); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return attachment, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return attachment, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return attachment, nil
+}
+
+func (a *attachment) getSQLAttachmentList(ctx context.Context, params entity.TaskAttachmentParam) ([]entity.TaskAttachment, *entity.Pagination, error) {
+	attachments := []entity.TaskAttachment{}
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return attachments, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := a.db.Follower().Query(ctx, "rListAttachment", getAttachment+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return attachments, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TaskAttachment{}
+		if err := rows.StructScan(&temp); err != nil {
+			a.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		attachments = append(attachments, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(attachments)),
+	}
+
+	if len(attachments) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := a.db.Follower().Get(ctx, "cAttachment", readAttachmentCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return attachments, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return attachments, &pg, nil
+}
+
+func (a *attachment) updateSQLAttachment(ctx context.Context, updateParam entity.UpdateTaskAttachmentParam, selectParam entity.TaskAttachmentParam) error {
+	a.log.Debug(ctx, fmt.Sprintf("update task attachment by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(a.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = a.db.Leader().Exec(ctx, "uAttachment", updateAttachment+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	a.log.Debug(ctx, fmt.Sprintf("successfully updated task attachment: %v", updateParam))
+
+	return nil
+}
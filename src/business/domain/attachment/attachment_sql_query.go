@@ -0,0 +1,35 @@
+package attachment
+
+const (
+	createAttachment = `
+	INSERT INTO task_attachment (fk_task_id, fk_user_id, file_name, mime_type, size, checksum, storage_key, created_by, updated_by)
+	    VALUES (:fk_task_id, :fk_user_id, :file_name, :mime_type, :size, :checksum, :storage_key, :created_by, :updated_by)`
+
+	getAttachment = `
+		SELECT
+			id,
+			fk_task_id,
+			fk_user_id,
+			file_name,
+			mime_type,
+			size,
+			checksum,
+			storage_key,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			task_attachment`
+
+	updateAttachment = `
+	UPDATE
+		task_attachment`
+
+	readAttachmentCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			task_attachment`
+)
@@ -0,0 +1,90 @@
+package board
+
+import (
+	"context"
+
+	boardColumnDom "github.com/adiatma85/gg-project/src/business/domain/boardcolumn"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, boardParam entity.CreateBoardParam) (entity.Board, error)
+	Get(ctx context.Context, params entity.BoardParam) (entity.Board, error)
+	GetList(ctx context.Context, params entity.BoardParam) ([]entity.Board, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateBoardParam, selectParam entity.BoardParam) error
+}
+
+type InitParam struct {
+	Log         log.Interface
+	Db          sql.Interface
+	Json        parser.JSONInterface
+	BoardColumn boardColumnDom.Interface
+}
+
+type board struct {
+	log         log.Interface
+	db          sql.Interface
+	json        parser.JSONInterface
+	boardColumn boardColumnDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	b := &board{
+		log:         param.Log,
+		db:          param.Db,
+		json:        param.Json,
+		boardColumn: param.BoardColumn,
+	}
+
+	return b
+}
+
+// Create the board and its columns in one transaction
+func (b *board) Create(ctx context.Context, boardParam entity.CreateBoardParam) (entity.Board, error) {
+	board := entity.Board{}
+
+	tx, err := b.db.Leader().BeginTx(ctx, "txcBoard", sql.TxOptions{})
+	if err != nil {
+		return board, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, board, err = b.createSQLBoard(tx, boardParam)
+	if err != nil {
+		return board, err
+	}
+
+	for _, column := range boardParam.Columns {
+		column.BoardID = board.ID
+		tx, err = b.boardColumn.CreateTx(tx, column)
+		if err != nil {
+			return board, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return board, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return b.Get(ctx, entity.BoardParam{
+		ID: null.Int64From(board.ID),
+	})
+}
+
+func (b *board) Get(ctx context.Context, params entity.BoardParam) (entity.Board, error) {
+	return b.getSQLBoard(ctx, params)
+}
+
+func (b *board) GetList(ctx context.Context, params entity.BoardParam) ([]entity.Board, *entity.Pagination, error) {
+	return b.getSQLBoardList(ctx, params)
+}
+
+func (b *board) Update(ctx context.Context, updateParam entity.UpdateBoardParam, selectParam entity.BoardParam) error {
+	return b.updateSQLBoard(ctx, updateParam, selectParam)
+}
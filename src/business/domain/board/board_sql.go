@@ -0,0 +1,143 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (b *board) createSQLBoard(tx sql.CommandTx, v entity.CreateBoardParam) (sql.CommandTx, entity.Board, error) {
+	board := entity.Board{}
+
+	res, err := tx.NamedExec("iCreateBoard", createBoard, v)
+	if err != nil {
+		return tx, board, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, board, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, board, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	board.ID = lastID
+
+	return tx, board, nil
+}
+
+func (b *board) getSQLBoard(ctx context.Context, params entity.BoardParam) (entity.Board, error) {
+	board := entity.Board{}
+
+	qb := query.NewSQLQueryBuilder(b.db, "param", "db", &params.QueryOption)
+	qb.AddPrefixQuery(b.getFilterQuery(params))
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return board, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := b.db.Follower().QueryRow(ctx, "rBoardByID", getBoard+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return board, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return board, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&board); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return board, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return board, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return board, nil
+}
+
+func (b *board) getSQLBoardList(ctx context.Context, params entity.BoardParam) ([]entity.Board, *entity.Pagination, error) {
+	boards := []entity.Board{}
+
+	qb := query.NewSQLQueryBuilder(b.db, "param", "db", &params.QueryOption)
+	qb.AddPrefixQuery(b.getFilterQuery(params))
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return boards, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := b.db.Follower().Query(ctx, "rListBoard", getBoard+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return boards, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.Board{}
+		if err := rows.StructScan(&temp); err != nil {
+			b.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		boards = append(boards, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(boards)),
+	}
+
+	if len(boards) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := b.db.Follower().Get(ctx, "cBoard", readBoardCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return boards, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return boards, &pg, nil
+}
+
+func (b *board) updateSQLBoard(ctx context.Context, updateParam entity.UpdateBoardParam, selectParam entity.BoardParam) error {
+	b.log.Debug(ctx, fmt.Sprintf("update board by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(b.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = b.db.Leader().Exec(ctx, "uBoard", updateBoard+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	b.log.Debug(ctx, fmt.Sprintf("successfully updated board: %v", updateParam))
+
+	return nil
+}
+
+// getFilterQuery return the filters that can not be expressed with the param tag
+func (b *board) getFilterQuery(params entity.BoardParam) string {
+	if !params.AccessUserID.Valid {
+		return ""
+	}
+
+	access := []string{fmt.Sprintf("fk_user_id = %d", params.AccessUserID.Int64)}
+	if len(params.AccessWorkspaceIDs) > 0 {
+		ids := []string{}
+		for _, id := range params.AccessWorkspaceIDs {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		access = append(access, fmt.Sprintf("fk_workspace_id IN (%s)", strings.Join(ids, ", ")))
+	}
+
+	return "(" + strings.Join(access, " OR ") + ")"
+}
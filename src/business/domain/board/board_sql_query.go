@@ -0,0 +1,32 @@
+package board
+
+const (
+	createBoard = `
+	INSERT INTO board (fk_user_id, fk_workspace_id, name, description, created_by, updated_by)
+	    VALUES (:fk_user_id, :fk_workspace_id, :name, :description, :created_by, :updated_by)`
+
+	getBoard = `
+		SELECT
+			id,
+			fk_user_id,
+			fk_workspace_id,
+			name,
+			description,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			board`
+
+	updateBoard = `
+	UPDATE
+		board`
+
+	readBoardCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			board`
+)
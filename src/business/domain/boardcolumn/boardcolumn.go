@@ -0,0 +1,84 @@
+package boardcolumn
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, columnParam entity.CreateBoardColumnParam) (entity.BoardColumn, error)
+	Get(ctx context.Context, params entity.BoardColumnParam) (entity.BoardColumn, error)
+	GetList(ctx context.Context, params entity.BoardColumnParam) ([]entity.BoardColumn, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateBoardColumnParam, selectParam entity.BoardColumnParam) error
+	CreateTx(tx sql.CommandTx, columnParam entity.CreateBoardColumnParam) (sql.CommandTx, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type boardColumn struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	bc := &boardColumn{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return bc
+}
+
+func (bc *boardColumn) Create(ctx context.Context, columnParam entity.CreateBoardColumnParam) (entity.BoardColumn, error) {
+	column := entity.BoardColumn{}
+
+	tx, err := bc.db.Leader().BeginTx(ctx, "txcBoardColumn", sql.TxOptions{})
+	if err != nil {
+		return column, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, column, err = bc.createSQLBoardColumn(tx, columnParam)
+	if err != nil {
+		return column, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return column, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return bc.Get(ctx, entity.BoardColumnParam{
+		ID: null.Int64From(column.ID),
+	})
+}
+
+func (bc *boardColumn) Get(ctx context.Context, params entity.BoardColumnParam) (entity.BoardColumn, error) {
+	return bc.getSQLBoardColumn(ctx, params)
+}
+
+func (bc *boardColumn) GetList(ctx context.Context, params entity.BoardColumnParam) ([]entity.BoardColumn, *entity.Pagination, error) {
+	return bc.getSQLBoardColumnList(ctx, params)
+}
+
+func (bc *boardColumn) Update(ctx context.Context, updateParam entity.UpdateBoardColumnParam, selectParam entity.BoardColumnParam) error {
+	return bc.updateSQLBoardColumn(ctx, updateParam, selectParam)
+}
+
+// CreateTx add the column to the board inside the transaction owned by the caller
+func (bc *boardColumn) CreateTx(tx sql.CommandTx, columnParam entity.CreateBoardColumnParam) (sql.CommandTx, error) {
+	tx, _, err := bc.createSQLBoardColumn(tx, columnParam)
+	return tx, err
+}
@@ -0,0 +1,121 @@
+package boardcolumn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (bc *boardColumn) createSQLBoardColumn(tx sql.CommandTx, v entity.CreateBoardColumnParam) (sql.CommandTx, entity.BoardColumn, error) {
+	column := entity.BoardColumn{}
+
+	res, err := tx.NamedExec("iCreateBoardColumn", createBoardColumn, v)
+	if err != nil {
+		return tx, column, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, column, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, column, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	column.ID = lastID
+
+	return tx, column, nil
+}
+
+func (bc *boardColumn) getSQLBoardColumn(ctx context.Context, params entity.BoardColumnParam) (entity.BoardColumn, error) {
+	column := entity.BoardColumn{}
+
+	qb := query.NewSQLQueryBuilder(bc.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return column, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := bc.db.Follower().QueryRow(ctx, "rBoardColumnByID", getBoardColumn+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return column, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return column, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&column); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return column, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return column, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return column, nil
+}
+
+func (bc *boardColumn) getSQLBoardColumnList(ctx context.Context, params entity.BoardColumnParam) ([]entity.BoardColumn, *entity.Pagination, error) {
+	columns := []entity.BoardColumn{}
+
+	qb := query.NewSQLQueryBuilder(bc.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return columns, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := bc.db.Follower().Query(ctx, "rListBoardColumn", getBoardColumn+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return columns, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.BoardColumn{}
+		if err := rows.StructScan(&temp); err != nil {
+			bc.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		columns = append(columns, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(columns)),
+	}
+
+	if len(columns) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := bc.db.Follower().Get(ctx, "cBoardColumn", readBoardColumnCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return columns, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return columns, &pg, nil
+}
+
+func (bc *boardColumn) updateSQLBoardColumn(ctx context.Context, updateParam entity.UpdateBoardColumnParam, selectParam entity.BoardColumnParam) error {
+	bc.log.Debug(ctx, fmt.Sprintf("update board column by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(bc.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = bc.db.Leader().Exec(ctx, "uBoardColumn", updateBoardColumn+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	bc.log.Debug(ctx, fmt.Sprintf("successfully updated board column: %v", updateParam))
+
+	return nil
+}
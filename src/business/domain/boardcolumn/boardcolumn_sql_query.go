@@ -0,0 +1,33 @@
+package boardcolumn
+
+const (
+	createBoardColumn = `
+	INSERT INTO board_column (fk_board_id, name, column_type, task_status, column_order, created_by, updated_by)
+	    VALUES (:fk_board_id, :name, :column_type, :task_status, :column_order, :created_by, :updated_by)`
+
+	getBoardColumn = `
+		SELECT
+			id,
+			fk_board_id,
+			name,
+			column_type,
+			task_status,
+			column_order,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			board_column`
+
+	updateBoardColumn = `
+	UPDATE
+		board_column`
+
+	readBoardColumnCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			board_column`
+)
@@ -0,0 +1,87 @@
+package boardtask
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	GetList(ctx context.Context, params entity.BoardTaskParam) ([]entity.BoardTask, *entity.Pagination, error)
+	Move(ctx context.Context, boardID, columnID int64, fn func(boardTasks []entity.BoardTask) ([]entity.CreateBoardTaskParam, error)) error
+	MoveTx(tx sql.CommandTx, boardID, columnID int64, fn func(boardTasks []entity.BoardTask) ([]entity.CreateBoardTaskParam, error)) (sql.CommandTx, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type boardTask struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	bt := &boardTask{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return bt
+}
+
+func (bt *boardTask) GetList(ctx context.Context, params entity.BoardTaskParam) ([]entity.BoardTask, *entity.Pagination, error) {
+	return bt.getSQLBoardTaskList(ctx, params)
+}
+
+// Move place the tasks returned by fn on the column in one transaction, see MoveTx
+func (bt *boardTask) Move(ctx context.Context, boardID, columnID int64, fn func(boardTasks []entity.BoardTask) ([]entity.CreateBoardTaskParam, error)) error {
+	tx, err := bt.db.Leader().BeginTx(ctx, "txuBoardTask", sql.TxOptions{})
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, err = bt.MoveTx(tx, boardID, columnID, fn)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return nil
+}
+
+// MoveTx lock the board and the column inside the transaction owned by the caller, so the moves on the board are
+// applied one at a time, then place the tasks returned by fn. fn gets the places of the tasks read after the lock
+func (bt *boardTask) MoveTx(tx sql.CommandTx, boardID, columnID int64, fn func(boardTasks []entity.BoardTask) ([]entity.CreateBoardTaskParam, error)) (sql.CommandTx, error) {
+	tx, boardTasks, err := bt.lockSQLBoardColumn(tx, boardID, columnID)
+	if err != nil {
+		return tx, err
+	}
+
+	boardTaskParams, err := fn(boardTasks)
+	if err != nil {
+		return tx, err
+	}
+
+	for _, boardTaskParam := range boardTaskParams {
+		tx, err = bt.upsertSQLBoardTask(tx, boardTaskParam)
+		if err != nil {
+			return tx, err
+		}
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,80 @@
+package boardtask
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (bt *boardTask) upsertSQLBoardTask(tx sql.CommandTx, v entity.CreateBoardTaskParam) (sql.CommandTx, error) {
+	if _, err := tx.NamedExec("iUpsertBoardTask", upsertBoardTask, v); err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	return tx, nil
+}
+
+// lockSQLBoardColumn lock the board and the column until the transaction ends and return the active places of the
+// board, the deleted board or column is not found
+func (bt *boardTask) lockSQLBoardColumn(tx sql.CommandTx, boardID, columnID int64) (sql.CommandTx, []entity.BoardTask, error) {
+	boardTasks := []entity.BoardTask{}
+
+	var lockedID int64
+	if err := tx.Get("rLockBoardColumn", lockBoardColumn, &lockedID, boardID, columnID); errors.Is(err, sql.ErrNotFound) {
+		return tx, boardTasks, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, fmt.Sprintf("column %d is not on board %d", columnID, boardID))
+	} else if err != nil {
+		return tx, boardTasks, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := tx.Select("rListBoardTaskTx", getBoardTask+getActiveBoardTaskByBoard, &boardTasks, boardID); err != nil {
+		return tx, boardTasks, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	return tx, boardTasks, nil
+}
+
+func (bt *boardTask) getSQLBoardTaskList(ctx context.Context, params entity.BoardTaskParam) ([]entity.BoardTask, *entity.Pagination, error) {
+	boardTasks := []entity.BoardTask{}
+
+	qb := query.NewSQLQueryBuilder(bt.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return boardTasks, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := bt.db.Follower().Query(ctx, "rListBoardTask", getBoardTask+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return boardTasks, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.BoardTask{}
+		if err := rows.StructScan(&temp); err != nil {
+			bt.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		boardTasks = append(boardTasks, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(boardTasks)),
+	}
+
+	if len(boardTasks) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := bt.db.Follower().Get(ctx, "cBoardTask", readBoardTaskCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return boardTasks, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return boardTasks, &pg, nil
+}
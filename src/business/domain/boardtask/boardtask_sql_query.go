@@ -0,0 +1,56 @@
+package boardtask
+
+const (
+	// The unique board task index keep one place per task on the board, placing the task again moves it
+	upsertBoardTask = `
+	INSERT INTO board_task (fk_board_id, fk_board_column_id, fk_task_id, position, created_by, updated_by)
+	    VALUES (:fk_board_id, :fk_board_column_id, :fk_task_id, :position, :created_by, :updated_by)
+	    ON DUPLICATE KEY UPDATE
+	        fk_board_column_id = VALUES(fk_board_column_id),
+	        position = VALUES(position),
+	        updated_by = VALUES(updated_by),
+	        deleted_at = NULL,
+	        deleted_by = NULL,
+	        status = 1`
+
+	getBoardTask = `
+		SELECT
+			id,
+			fk_board_id,
+			fk_board_column_id,
+			fk_task_id,
+			position,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			board_task`
+
+	getActiveBoardTaskByBoard = `
+		WHERE
+			fk_board_id = ?
+			AND status = 1`
+
+	// Locking the rows of the board and the column serializes the moves on the board and waits for the column to be
+	// deleted or kept
+	lockBoardColumn = `
+		SELECT
+			board_column.id
+		FROM
+			board
+			JOIN board_column ON board_column.fk_board_id = board.id
+		WHERE
+			board.id = ?
+			AND board.status = 1
+			AND board_column.id = ?
+			AND board_column.status = 1
+		FOR UPDATE`
+
+	readBoardTaskCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			board_task`
+)
@@ -17,6 +17,7 @@ type Interface interface {
 	Get(ctx context.Context, params entity.CategoryParam) (entity.Category, error)
 	GetList(ctx context.Context, params entity.CategoryParam) ([]entity.Category, *entity.Pagination, error)
 	Update(ctx context.Context, updateParam entity.UpdateCategoryParam, selectParam entity.CategoryParam) error
+	CreateTx(tx sql.CommandTx, categoryParam entity.CreateCategoryParam) (sql.CommandTx, entity.Category, error)
 }
 
 type InitParam struct {
@@ -75,3 +76,8 @@ func (c *category) GetList(ctx context.Context, params entity.CategoryParam) ([]
 func (c *category) Update(ctx context.Context, updateParam entity.UpdateCategoryParam, selectParam entity.CategoryParam) error {
 	return c.updateSQLCategory(ctx, updateParam, selectParam)
 }
+
+// CreateTx create the category inside the transaction owned by the caller
+func (c *category) CreateTx(tx sql.CommandTx, categoryParam entity.CreateCategoryParam) (sql.CommandTx, entity.Category, error) {
+	return c.createSQLCategory(tx, categoryParam)
+}
@@ -3,6 +3,8 @@ package category
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/adiatma85/gg-project/src/business/entity"
 	"github.com/adiatma85/own-go-sdk/codes"
@@ -38,6 +40,7 @@ func (c *category) getSQLCategory(ctx context.Context, params entity.CategoryPar
 	category := entity.Category{}
 
 	qb := query.NewSQLQueryBuilder(c.db, "param", "db", &params.QueryOption)
+	qb.AddPrefixQuery(c.getFilterQuery(params))
 	queryExt, queryArgs, _, _, err := qb.Build(&params)
 	if err != nil {
 		return category, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
@@ -63,6 +66,7 @@ func (c *category) getSQLCategoryList(ctx context.Context, params entity.Categor
 	categories := []entity.Category{}
 
 	qb := query.NewSQLQueryBuilder(c.db, "param", "db", &params.QueryOption)
+	qb.AddPrefixQuery(c.getFilterQuery(params))
 	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
 	if err != nil {
 		return categories, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
@@ -100,6 +104,24 @@ func (c *category) getSQLCategoryList(ctx context.Context, params entity.Categor
 	return categories, &pg, nil
 }
 
+// getFilterQuery return the filters that can not be expressed with the param tag
+func (c *category) getFilterQuery(params entity.CategoryParam) string {
+	if !params.RestrictWorkspace {
+		return ""
+	}
+
+	if len(params.AccessWorkspaceIDs) == 0 {
+		return "fk_workspace_id IS NULL"
+	}
+
+	ids := []string{}
+	for _, id := range params.AccessWorkspaceIDs {
+		ids = append(ids, strconv.FormatInt(id, 10))
+	}
+
+	return fmt.Sprintf("(fk_workspace_id IS NULL OR fk_workspace_id IN (%s))", strings.Join(ids, ", "))
+}
+
 func (c *category) updateSQLCategory(ctx context.Context, updateParam entity.UpdateCategoryParam, selectParam entity.CategoryParam) error {
 	c.log.Debug(ctx, fmt.Sprintf("update category by: %v", selectParam))
 
@@ -2,12 +2,13 @@ package category
 
 const (
 	createCategory = `
-	INSERT INTO category (name, created_by, updated_by)
-	    VALUES (:name, :created_by, :updated_by)`
+	INSERT INTO category (fk_workspace_id, name, created_by, updated_by)
+	    VALUES (:fk_workspace_id, :name, :created_by, :updated_by)`
 
 	getCategory = `
 		SELECT
 			id,
+			fk_workspace_id,
 			name,
 			status,
 			created_at,
@@ -0,0 +1,77 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, commentParam entity.CreateTaskCommentParam) (entity.TaskComment, error)
+	Get(ctx context.Context, params entity.TaskCommentParam) (entity.TaskComment, error)
+	GetList(ctx context.Context, params entity.TaskCommentParam) ([]entity.TaskComment, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTaskCommentParam, selectParam entity.TaskCommentParam) error
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type comment struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	c := &comment{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return c
+}
+
+func (c *comment) Create(ctx context.Context, commentParam entity.CreateTaskCommentParam) (entity.TaskComment, error) {
+	comment := entity.TaskComment{}
+
+	tx, err := c.db.Leader().BeginTx(ctx, "txcComment", sql.TxOptions{})
+	if err != nil {
+		return comment, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, comment, err = c.createSQLComment(tx, commentParam)
+	if err != nil {
+		return comment, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return comment, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return c.Get(ctx, entity.TaskCommentParam{
+		ID: null.Int64From(comment.ID),
+	})
+}
+
+func (c *comment) Get(ctx context.Context, params entity.TaskCommentParam) (entity.TaskComment, error) {
+	return c.getSQLComment(ctx, params)
+}
+
+func (c *comment) GetList(ctx context.Context, params entity.TaskCommentParam) ([]entity.TaskComment, *entity.Pagination, error) {
+	return c.getSQLCommentList(ctx, params)
+}
+
+func (c *comment) Update(ctx context.Context, updateParam entity.UpdateTaskCommentParam, selectParam entity.TaskCommentParam) error {
+	return c.updateSQLComment(ctx, updateParam, selectParam)
+}
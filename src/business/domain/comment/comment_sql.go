@@ -0,0 +1,122 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (c *comment) createSQLComment(tx sql.CommandTx, v entity.CreateTaskCommentParam) (sql.CommandTx, entity.TaskComment, error) {
+	comment := entity.TaskComment{}
+
+	res, err := tx.NamedExec("iCreateComment", createComment, v)
+	if err != nil {
+		return tx, comment, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, comment, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, comment, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	comment.ID = lastID
+
+	return tx, comment, nil
+}
+
+func (c *comment) getSQLComment(ctx context.Context, params entity.TaskCommentParam) (entity.TaskComment, error) {
+	comment := entity.TaskComment{}
+
+	qb := query.NewSQLQueryBuilder(c.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return comment, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := c.db.Follower().QueryRow(ctx, "rCommentByID", getComment+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return comment, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return comment, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&comment); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return comment, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return comment, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return comment, nil
+}
+
+func (c *comment) getSQLCommentList(ctx context.Context, params entity.TaskCommentParam) ([]entity.TaskComment, *entity.Pagination, error) {
+	comments := []entity.TaskComment{}
+
+	qb := query.NewSQLQueryBuilder(c.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return comments, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := c.db.Follower().Query(ctx, "rListComment", getComment+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return comments, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TaskComment{}
+		if err := rows.StructScan(&temp); err != nil {
+			c.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		comments = append(comments, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(comments)),
+	}
+
+	if len(comments) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := c.db.Follower().Get(ctx, "cComment", readCommentCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return comments, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return comments, &pg, nil
+}
+
+func (c *comment) updateSQLComment(ctx context.Context, updateParam entity.UpdateTaskCommentParam, selectParam entity.TaskCommentParam) error {
+	c.log.Debug(ctx, fmt.Sprintf("update task comment by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(c.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = c.db.Leader().Exec(ctx, "uComment", updateComment+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	c.log.Debug(ctx, fmt.Sprintf("successfully updated task comment: %v", updateParam))
+
+	return nil
+}
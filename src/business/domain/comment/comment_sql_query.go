@@ -0,0 +1,31 @@
+package comment
+
+const (
+	createComment = `
+	INSERT INTO task_comment (fk_task_id, fk_user_id, content, created_by, updated_by)
+	    VALUES (:fk_task_id, :fk_user_id, :content, :created_by, :updated_by)`
+
+	getComment = `
+		SELECT
+			id,
+			fk_task_id,
+			fk_user_id,
+			content,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			task_comment`
+
+	updateComment = `
+	UPDATE
+		task_comment`
+
+	readCommentCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			task_comment`
+)
@@ -1,35 +1,114 @@
 package domain
 
 import (
+	"github.com/adiatma85/gg-project/src/business/domain/achievement"
+	"github.com/adiatma85/gg-project/src/business/domain/attachment"
+	"github.com/adiatma85/gg-project/src/business/domain/board"
+	"github.com/adiatma85/gg-project/src/business/domain/boardcolumn"
+	"github.com/adiatma85/gg-project/src/business/domain/boardtask"
 	"github.com/adiatma85/gg-project/src/business/domain/category"
+	"github.com/adiatma85/gg-project/src/business/domain/comment"
+	"github.com/adiatma85/gg-project/src/business/domain/focussession"
+	"github.com/adiatma85/gg-project/src/business/domain/habitcheckin"
+	"github.com/adiatma85/gg-project/src/business/domain/leaderboard"
+	"github.com/adiatma85/gg-project/src/business/domain/notifier"
 	"github.com/adiatma85/gg-project/src/business/domain/role"
+	"github.com/adiatma85/gg-project/src/business/domain/search"
+	"github.com/adiatma85/gg-project/src/business/domain/storage"
+	"github.com/adiatma85/gg-project/src/business/domain/tag"
 	"github.com/adiatma85/gg-project/src/business/domain/task"
+	"github.com/adiatma85/gg-project/src/business/domain/taskdependency"
+	"github.com/adiatma85/gg-project/src/business/domain/taskreminder"
+	"github.com/adiatma85/gg-project/src/business/domain/taskstatushistory"
+	"github.com/adiatma85/gg-project/src/business/domain/tasktag"
+	"github.com/adiatma85/gg-project/src/business/domain/timeentry"
 	"github.com/adiatma85/gg-project/src/business/domain/user"
+	"github.com/adiatma85/gg-project/src/business/domain/userachievement"
+	"github.com/adiatma85/gg-project/src/business/domain/userprogress"
+	"github.com/adiatma85/gg-project/src/business/domain/workspace"
+	"github.com/adiatma85/gg-project/src/business/domain/workspaceinvitation"
+	"github.com/adiatma85/gg-project/src/business/domain/workspacemember"
 	"github.com/adiatma85/own-go-sdk/log"
 	"github.com/adiatma85/own-go-sdk/parser"
 	"github.com/adiatma85/own-go-sdk/sql"
+	"github.com/go-redis/redis/v8"
 )
 
 type Domain struct {
-	User     user.Interface
-	Category category.Interface
-	Task     task.Interface
-	Role     role.Interface
+	User                user.Interface
+	Category            category.Interface
+	Task                task.Interface
+	Role                role.Interface
+	TaskDependency      taskdependency.Interface
+	TaskStatusHistory   taskstatushistory.Interface
+	Comment             comment.Interface
+	Attachment          attachment.Interface
+	Storage             storage.Interface
+	Tag                 tag.Interface
+	TaskTag             tasktag.Interface
+	Search              search.Interface
+	TaskReminder        taskreminder.Interface
+	Notifier            notifier.Interface
+	TimeEntry           timeentry.Interface
+	FocusSession        focussession.Interface
+	UserProgress        userprogress.Interface
+	Achievement         achievement.Interface
+	UserAchievement     userachievement.Interface
+	Leaderboard         leaderboard.Interface
+	HabitCheckIn        habitcheckin.Interface
+	Workspace           workspace.Interface
+	WorkspaceMember     workspacemember.Interface
+	WorkspaceInvitation workspaceinvitation.Interface
+	Board               board.Interface
+	BoardColumn         boardcolumn.Interface
+	BoardTask           boardtask.Interface
 }
 
 type InitParam struct {
-	Log  log.Interface
-	Db   sql.Interface
-	Json parser.JSONInterface
+	Log      log.Interface
+	Db       sql.Interface
+	Json     parser.JSONInterface
+	Storage  storage.Config
+	Notifier notifier.Config
+	Redis    *redis.Client
 }
 
 func Init(param InitParam) *Domain {
 	domain := &Domain{
-		User:     user.Init(user.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
-		Category: category.Init(category.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
-		Task:     task.Init(task.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
-		Role:     role.Init(role.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		User:                user.Init(user.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Category:            category.Init(category.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Role:                role.Init(role.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		TaskDependency:      taskdependency.Init(taskdependency.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		TaskStatusHistory:   taskstatushistory.Init(taskstatushistory.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Comment:             comment.Init(comment.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Attachment:          attachment.Init(attachment.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Storage:             storage.Init(storage.InitParam{Log: param.Log, Conf: param.Storage}),
+		Tag:                 tag.Init(tag.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		TaskTag:             tasktag.Init(tasktag.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Search:              search.Init(search.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		TaskReminder:        taskreminder.Init(taskreminder.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Notifier:            notifier.Init(notifier.InitParam{Log: param.Log, Conf: param.Notifier}),
+		TimeEntry:           timeentry.Init(timeentry.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		FocusSession:        focussession.Init(focussession.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		UserProgress:        userprogress.Init(userprogress.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Achievement:         achievement.Init(achievement.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		UserAchievement:     userachievement.Init(userachievement.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		Leaderboard:         leaderboard.Init(leaderboard.InitParam{Log: param.Log, Redis: param.Redis}),
+		HabitCheckIn:        habitcheckin.Init(habitcheckin.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		WorkspaceMember:     workspacemember.Init(workspacemember.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		WorkspaceInvitation: workspaceinvitation.Init(workspaceinvitation.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		BoardColumn:         boardcolumn.Init(boardcolumn.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
+		BoardTask:           boardtask.Init(boardtask.InitParam{Log: param.Log, Db: param.Db, Json: param.Json}),
 	}
 
+	// Task import create the missing categories and the status change records its history in the same transaction
+	domain.Task = task.Init(task.InitParam{Log: param.Log, Db: param.Db, Json: param.Json, Category: domain.Category, TaskStatusHistory: domain.TaskStatusHistory})
+
+	// Workspace create its owner and transfer its ownership in the same transaction as the members
+	domain.Workspace = workspace.Init(workspace.InitParam{Log: param.Log, Db: param.Db, Json: param.Json, WorkspaceMember: domain.WorkspaceMember})
+
+	// Board create its columns in the same transaction
+	domain.Board = board.Init(board.InitParam{Log: param.Log, Db: param.Db, Json: param.Json, BoardColumn: domain.BoardColumn})
+
 	return domain
 }
@@ -0,0 +1,89 @@
+package focussession
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, focusSessionParam entity.CreateFocusSessionParam) (entity.FocusSession, error)
+	Get(ctx context.Context, params entity.FocusSessionParam) (entity.FocusSession, error)
+	GetList(ctx context.Context, params entity.FocusSessionParam) ([]entity.FocusSession, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateFocusSessionParam, selectParam entity.FocusSessionParam) error
+	GetDaily(ctx context.Context, params entity.FocusReportParam) ([]entity.FocusDay, error)
+	GetTotal(ctx context.Context, userID int64) (entity.FocusTotal, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type focusSession struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	fs := &focusSession{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return fs
+}
+
+func (fs *focusSession) Create(ctx context.Context, focusSessionParam entity.CreateFocusSessionParam) (entity.FocusSession, error) {
+	focusSession := entity.FocusSession{}
+
+	tx, err := fs.db.Leader().BeginTx(ctx, "txcFocusSession", sql.TxOptions{})
+	if err != nil {
+		return focusSession, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, focusSession, err = fs.createSQLFocusSession(tx, focusSessionParam)
+	if err != nil {
+		return focusSession, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return focusSession, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return fs.Get(ctx, entity.FocusSessionParam{
+		ID: null.Int64From(focusSession.ID),
+	})
+}
+
+func (fs *focusSession) Get(ctx context.Context, params entity.FocusSessionParam) (entity.FocusSession, error) {
+	return fs.getSQLFocusSession(ctx, params)
+}
+
+func (fs *focusSession) GetList(ctx context.Context, params entity.FocusSessionParam) ([]entity.FocusSession, *entity.Pagination, error) {
+	return fs.getSQLFocusSessionList(ctx, params)
+}
+
+func (fs *focusSession) Update(ctx context.Context, updateParam entity.UpdateFocusSessionParam, selectParam entity.FocusSessionParam) error {
+	return fs.updateSQLFocusSession(ctx, updateParam, selectParam)
+}
+
+// GetDaily sum the focus time and the ended sessions of the user per day
+func (fs *focusSession) GetDaily(ctx context.Context, params entity.FocusReportParam) ([]entity.FocusDay, error) {
+	return fs.getSQLFocusDaily(ctx, params)
+}
+
+// GetTotal sum the focus time and the completed sessions of the user
+func (fs *focusSession) GetTotal(ctx context.Context, userID int64) (entity.FocusTotal, error) {
+	return fs.getSQLFocusTotal(ctx, userID)
+}
@@ -0,0 +1,172 @@
+package focussession
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/sqlutil"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+// Unique index of the running sessions, a user can only focus on one task at a time
+const uniqueRunningUser = "idx_focus_session_running_user_id"
+
+func (fs *focusSession) createSQLFocusSession(tx sql.CommandTx, v entity.CreateFocusSessionParam) (sql.CommandTx, entity.FocusSession, error) {
+	focusSession := entity.FocusSession{}
+
+	res, err := tx.NamedExec("iCreateFocusSession", createFocusSession, v)
+	if sqlutil.IsDuplicateEntry(err, uniqueRunningUser) {
+		return tx, focusSession, errors.NewWithCode(codes.CodeConflict, "focus session is already running")
+	} else if err != nil {
+		return tx, focusSession, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, focusSession, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, focusSession, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	focusSession.ID = lastID
+
+	return tx, focusSession, nil
+}
+
+func (fs *focusSession) getSQLFocusSession(ctx context.Context, params entity.FocusSessionParam) (entity.FocusSession, error) {
+	focusSession := entity.FocusSession{}
+
+	qb := query.NewSQLQueryBuilder(fs.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return focusSession, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := fs.db.Follower().QueryRow(ctx, "rFocusSessionByID", getFocusSession+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return focusSession, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return focusSession, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&focusSession); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return focusSession, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return focusSession, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	focusSession.ProcessBreak()
+
+	return focusSession, nil
+}
+
+func (fs *focusSession) getSQLFocusSessionList(ctx context.Context, params entity.FocusSessionParam) ([]entity.FocusSession, *entity.Pagination, error) {
+	focusSessions := []entity.FocusSession{}
+
+	qb := query.NewSQLQueryBuilder(fs.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return focusSessions, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := fs.db.Follower().Query(ctx, "rListFocusSession", getFocusSession+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return focusSessions, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.FocusSession{}
+		if err := rows.StructScan(&temp); err != nil {
+			fs.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		temp.ProcessBreak()
+		focusSessions = append(focusSessions, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(focusSessions)),
+	}
+
+	if len(focusSessions) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := fs.db.Follower().Get(ctx, "cFocusSession", readFocusSessionCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return focusSessions, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return focusSessions, &pg, nil
+}
+
+func (fs *focusSession) updateSQLFocusSession(ctx context.Context, updateParam entity.UpdateFocusSessionParam, selectParam entity.FocusSessionParam) error {
+	fs.log.Debug(ctx, fmt.Sprintf("update focus session by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(fs.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = fs.db.Leader().Exec(ctx, "uFocusSession", updateFocusSession+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	fs.log.Debug(ctx, fmt.Sprintf("successfully updated focus session: %v", updateParam))
+
+	return nil
+}
+
+func (fs *focusSession) getSQLFocusDaily(ctx context.Context, params entity.FocusReportParam) ([]entity.FocusDay, error) {
+	results := []entity.FocusDay{}
+
+	// The day is taken in the location of the user, e.g. +07:00
+	offset := params.From.In(params.Location).Format("-07:00")
+
+	rows, err := fs.db.Follower().Query(ctx, "rFocusDaily", readFocusDaily, offset, params.UserID, params.From, params.To)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return results, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.FocusDay{}
+		if err := rows.StructScan(&temp); err != nil {
+			fs.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		temp.FocusMinutes = temp.FocusSeconds / 60
+		results = append(results, temp)
+	}
+
+	return results, nil
+}
+
+func (fs *focusSession) getSQLFocusTotal(ctx context.Context, userID int64) (entity.FocusTotal, error) {
+	result := entity.FocusTotal{}
+
+	row, err := fs.db.Follower().QueryRow(ctx, "rFocusTotal", readFocusTotal, userID)
+	if err != nil {
+		return result, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	if err := row.StructScan(&result); err != nil {
+		return result, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package focussession
+
+const (
+	createFocusSession = `
+	INSERT INTO focus_session (fk_task_id, fk_user_id, work_minutes, break_minutes, state, started_at, ends_at, created_by, updated_by)
+	    VALUES (:fk_task_id, :fk_user_id, :work_minutes, :break_minutes, :state, :started_at, :ends_at, :created_by, :updated_by)`
+
+	getFocusSession = `
+		SELECT
+			id,
+			fk_task_id,
+			fk_user_id,
+			work_minutes,
+			break_minutes,
+			state,
+			started_at,
+			ends_at,
+			ended_at,
+			focus_seconds,
+			interrupt_reason,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			focus_session`
+
+	updateFocusSession = `
+	UPDATE
+		focus_session`
+
+	readFocusSessionCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			focus_session`
+
+	// The running session is not counted yet, it is only known how long it was focused once it ends
+	readFocusDaily = `
+		SELECT
+			DATE(CONVERT_TZ(started_at, @@session.time_zone, ?)) AS day,
+			SUM(focus_seconds) AS focus_seconds,
+			SUM(IF(state = 'completed', 1, 0)) AS completed,
+			SUM(IF(state = 'interrupted', 1, 0)) AS interrupted
+		FROM
+			focus_session
+		WHERE
+			status = 1
+			AND state <> 'running'
+			AND fk_user_id = ?
+			AND started_at >= ?
+			AND started_at < ?
+		GROUP BY
+			day
+		ORDER BY
+			day`
+
+	readFocusTotal = `
+		SELECT
+			COALESCE(SUM(focus_seconds), 0) AS focus_seconds,
+			COALESCE(SUM(IF(state = 'completed', 1, 0)), 0) AS completed
+		FROM
+			focus_session
+		WHERE
+			status = 1
+			AND state <> 'running'
+			AND fk_user_id = ?`
+)
@@ -0,0 +1,79 @@
+package habitcheckin
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, checkInParam entity.CreateHabitCheckInParam) (entity.HabitCheckIn, error)
+	Get(ctx context.Context, params entity.HabitCheckInParam) (entity.HabitCheckIn, error)
+	GetList(ctx context.Context, params entity.HabitCheckInParam) ([]entity.HabitCheckIn, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateHabitCheckInParam, selectParam entity.HabitCheckInParam) error
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type habitCheckIn struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	hc := &habitCheckIn{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return hc
+}
+
+// Create check in the habit on the day, the deleted check in of the same day is restored instead
+func (hc *habitCheckIn) Create(ctx context.Context, checkInParam entity.CreateHabitCheckInParam) (entity.HabitCheckIn, error) {
+	checkIn := entity.HabitCheckIn{}
+
+	tx, err := hc.db.Leader().BeginTx(ctx, "txcHabitCheckIn", sql.TxOptions{})
+	if err != nil {
+		return checkIn, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, err = hc.createSQLHabitCheckIn(tx, checkInParam)
+	if err != nil {
+		return checkIn, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return checkIn, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return hc.Get(ctx, entity.HabitCheckInParam{
+		TaskID:      null.Int64From(checkInParam.TaskID),
+		CheckInDate: checkInParam.CheckInDate,
+	})
+}
+
+func (hc *habitCheckIn) Get(ctx context.Context, params entity.HabitCheckInParam) (entity.HabitCheckIn, error) {
+	return hc.getSQLHabitCheckIn(ctx, params)
+}
+
+func (hc *habitCheckIn) GetList(ctx context.Context, params entity.HabitCheckInParam) ([]entity.HabitCheckIn, *entity.Pagination, error) {
+	return hc.getSQLHabitCheckInList(ctx, params)
+}
+
+func (hc *habitCheckIn) Update(ctx context.Context, updateParam entity.UpdateHabitCheckInParam, selectParam entity.HabitCheckInParam) error {
+	return hc.updateSQLHabitCheckIn(ctx, updateParam, selectParam)
+}
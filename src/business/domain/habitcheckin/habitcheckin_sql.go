@@ -0,0 +1,108 @@
+package habitcheckin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (hc *habitCheckIn) createSQLHabitCheckIn(tx sql.CommandTx, v entity.CreateHabitCheckInParam) (sql.CommandTx, error) {
+	// No row is affected when the day is already checked in, the check in is read afterward either way
+	if _, err := tx.NamedExec("iCreateHabitCheckIn", createHabitCheckIn, v); err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	return tx, nil
+}
+
+func (hc *habitCheckIn) getSQLHabitCheckIn(ctx context.Context, params entity.HabitCheckInParam) (entity.HabitCheckIn, error) {
+	checkIn := entity.HabitCheckIn{}
+
+	qb := query.NewSQLQueryBuilder(hc.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return checkIn, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := hc.db.Follower().QueryRow(ctx, "rHabitCheckInByID", getHabitCheckIn+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return checkIn, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return checkIn, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&checkIn); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return checkIn, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return checkIn, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return checkIn, nil
+}
+
+func (hc *habitCheckIn) getSQLHabitCheckInList(ctx context.Context, params entity.HabitCheckInParam) ([]entity.HabitCheckIn, *entity.Pagination, error) {
+	checkIns := []entity.HabitCheckIn{}
+
+	qb := query.NewSQLQueryBuilder(hc.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return checkIns, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := hc.db.Follower().Query(ctx, "rListHabitCheckIn", getHabitCheckIn+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return checkIns, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.HabitCheckIn{}
+		if err := rows.StructScan(&temp); err != nil {
+			hc.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		checkIns = append(checkIns, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(checkIns)),
+	}
+
+	if len(checkIns) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := hc.db.Follower().Get(ctx, "cHabitCheckIn", readHabitCheckInCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return checkIns, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return checkIns, &pg, nil
+}
+
+func (hc *habitCheckIn) updateSQLHabitCheckIn(ctx context.Context, updateParam entity.UpdateHabitCheckInParam, selectParam entity.HabitCheckInParam) error {
+	hc.log.Debug(ctx, fmt.Sprintf("update habit check in by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(hc.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = hc.db.Leader().Exec(ctx, "uHabitCheckIn", updateHabitCheckIn+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	hc.log.Debug(ctx, fmt.Sprintf("successfully updated habit check in: %v", updateParam))
+
+	return nil
+}
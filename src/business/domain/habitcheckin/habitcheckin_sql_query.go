@@ -0,0 +1,37 @@
+package habitcheckin
+
+const (
+	// The unique day index restore the deleted check in, an active check in is left as is
+	createHabitCheckIn = `
+	INSERT INTO habit_checkin (fk_task_id, fk_user_id, checkin_date, created_by, updated_by)
+	    VALUES (:fk_task_id, :fk_user_id, :checkin_date, :created_by, :updated_by)
+	    ON DUPLICATE KEY UPDATE
+	        updated_by = IF(status = 1, updated_by, VALUES(updated_by)),
+	        deleted_at = IF(status = 1, deleted_at, NULL),
+	        deleted_by = IF(status = 1, deleted_by, NULL),
+	        status = 1`
+
+	getHabitCheckIn = `
+		SELECT
+			id,
+			fk_task_id,
+			fk_user_id,
+			checkin_date,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			habit_checkin`
+
+	updateHabitCheckIn = `
+	UPDATE
+		habit_checkin`
+
+	readHabitCheckInCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			habit_checkin`
+)
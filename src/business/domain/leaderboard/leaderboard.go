@@ -0,0 +1,178 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// WeeklyTTL keep the weekly leaderboard a few weeks after it ends, so the last weeks can still be read
+const WeeklyTTL = 5 * 7 * 24 * time.Hour
+
+// Interface is the leaderboards stored as redis sorted sets, the member is the user id and the score is its metric
+type Interface interface {
+	Incr(ctx context.Context, key entity.LeaderboardKey, userID int64, score int64) error
+	Set(ctx context.Context, key entity.LeaderboardKey, userID int64, score int64) error
+	Remove(ctx context.Context, key entity.LeaderboardKey, userID int64) error
+	GetList(ctx context.Context, key entity.LeaderboardKey, page, limit int64) ([]entity.LeaderboardEntry, *entity.Pagination, error)
+	GetRank(ctx context.Context, key entity.LeaderboardKey, userID int64) (entity.LeaderboardEntry, error)
+	GetScores(ctx context.Context, key entity.LeaderboardKey, userIDs []int64) (map[int64]int64, error)
+}
+
+type InitParam struct {
+	Log   log.Interface
+	Redis *redis.Client
+}
+
+type leaderboard struct {
+	log log.Interface
+	rdb *redis.Client
+}
+
+// Init use the redis client shared by the application, the sdk interface does not expose the sorted sets
+func Init(param InitParam) Interface {
+	l := &leaderboard{
+		log: param.Log,
+		rdb: param.Redis,
+	}
+
+	return l
+}
+
+// Incr add the score to the user, the weekly leaderboard expire after WeeklyTTL
+func (l *leaderboard) Incr(ctx context.Context, key entity.LeaderboardKey, userID int64, score int64) error {
+	pipe := l.rdb.TxPipeline()
+	pipe.ZIncrBy(ctx, key.String(), float64(score), member(userID))
+	if key.Period == entity.LeaderboardPeriodWeekly {
+		pipe.Expire(ctx, key.String(), WeeklyTTL)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.NewWithCode(codes.CodeRedisSetex, fmt.Sprintf(entity.ErrorRedis, err))
+	}
+
+	return nil
+}
+
+// Set replace the score of the user
+func (l *leaderboard) Set(ctx context.Context, key entity.LeaderboardKey, userID int64, score int64) error {
+	if err := l.rdb.ZAdd(ctx, key.String(), &redis.Z{Score: float64(score), Member: member(userID)}).Err(); err != nil {
+		return errors.NewWithCode(codes.CodeRedisSetex, fmt.Sprintf(entity.ErrorRedis, err))
+	}
+
+	return nil
+}
+
+func (l *leaderboard) Remove(ctx context.Context, key entity.LeaderboardKey, userID int64) error {
+	if err := l.rdb.ZRem(ctx, key.String(), member(userID)).Err(); err != nil {
+		return errors.NewWithCode(codes.CodeRedisSetex, fmt.Sprintf(entity.ErrorRedis, err))
+	}
+
+	return nil
+}
+
+// GetList return the page of the leaderboard from the highest score, only the rank, user id and score are filled
+func (l *leaderboard) GetList(ctx context.Context, key entity.LeaderboardKey, page, limit int64) ([]entity.LeaderboardEntry, *entity.Pagination, error) {
+	if page < 1 {
+		page = 1
+	}
+	offset := (page - 1) * limit
+
+	total, err := l.rdb.ZCard(ctx, key.String()).Result()
+	if err != nil {
+		return nil, nil, errors.NewWithCode(codes.CodeRedisGet, fmt.Sprintf(entity.ErrorRedis, err))
+	}
+
+	members, err := l.rdb.ZRevRangeWithScores(ctx, key.String(), offset, offset+limit-1).Result()
+	if err != nil {
+		return nil, nil, errors.NewWithCode(codes.CodeRedisGet, fmt.Sprintf(entity.ErrorRedis, err))
+	}
+
+	entries := []entity.LeaderboardEntry{}
+	for i, z := range members {
+		userID, err := strconv.ParseInt(fmt.Sprintf("%v", z.Member), 10, 64)
+		if err != nil {
+			l.log.Warn(ctx, fmt.Sprintf("invalid member %v on leaderboard %s", z.Member, key))
+			continue
+		}
+
+		entries = append(entries, entity.LeaderboardEntry{
+			Rank:   offset + int64(i) + 1,
+			UserID: userID,
+			Score:  int64(z.Score),
+		})
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     page,
+		CurrentElements: int64(len(entries)),
+		TotalElements:   total,
+	}
+	pg.ProcessPagination(limit)
+
+	return entries, &pg, nil
+}
+
+// GetRank return the rank and score of the user, the rank is zero when the user is not on the leaderboard
+func (l *leaderboard) GetRank(ctx context.Context, key entity.LeaderboardKey, userID int64) (entity.LeaderboardEntry, error) {
+	entry := entity.LeaderboardEntry{UserID: userID}
+
+	rank, err := l.rdb.ZRevRank(ctx, key.String(), member(userID)).Result()
+	if err == redis.Nil {
+		return entry, nil
+	} else if err != nil {
+		return entry, errors.NewWithCode(codes.CodeRedisGet, fmt.Sprintf(entity.ErrorRedis, err))
+	}
+
+	score, err := l.rdb.ZScore(ctx, key.String(), member(userID)).Result()
+	if err != nil && err != redis.Nil {
+		return entry, errors.NewWithCode(codes.CodeRedisGet, fmt.Sprintf(entity.ErrorRedis, err))
+	}
+
+	entry.Rank = rank + 1
+	entry.Score = int64(score)
+
+	return entry, nil
+}
+
+// GetScores return the scores of the users, the user who is not on the leaderboard is left out
+func (l *leaderboard) GetScores(ctx context.Context, key entity.LeaderboardKey, userIDs []int64) (map[int64]int64, error) {
+	scores := map[int64]int64{}
+	if len(userIDs) == 0 {
+		return scores, nil
+	}
+
+	pipe := l.rdb.Pipeline()
+	cmds := map[int64]*redis.FloatCmd{}
+	for _, userID := range userIDs {
+		cmds[userID] = pipe.ZScore(ctx, key.String(), member(userID))
+	}
+
+	// The missing member fail its own command with redis.Nil, it is checked per command below
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errors.NewWithCode(codes.CodeRedisGet, fmt.Sprintf(entity.ErrorRedis, err))
+	}
+
+	for userID, cmd := range cmds {
+		score, err := cmd.Result()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return nil, errors.NewWithCode(codes.CodeRedisGet, fmt.Sprintf(entity.ErrorRedis, err))
+		}
+		scores[userID] = int64(score)
+	}
+
+	return scores, nil
+}
+
+func member(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
@@ -0,0 +1,24 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/adiatma85/own-go-sdk/log"
+)
+
+type logNotifier struct {
+	log log.Interface
+}
+
+func initLog(log log.Interface) Interface {
+	return &logNotifier{
+		log: log,
+	}
+}
+
+func (l *logNotifier) Send(ctx context.Context, msg Message) error {
+	l.log.Info(ctx, fmt.Sprintf("notification to %s: %s", strings.Join(msg.To, ", "), msg.Subject))
+	return nil
+}
@@ -0,0 +1,48 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/own-go-sdk/log"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverLog  = "log"
+)
+
+type Message struct {
+	To      []string
+	Subject string
+	Body    string // Plain text body
+}
+
+// Interface is the channel used to deliver notification to the user
+type Interface interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Config struct {
+	Driver string
+	SMTP   SMTPConfig
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Conf Config
+}
+
+// Init return the notifier based on the configured driver, log driver only write the message to the log. The server
+// does not start with an unknown driver, otherwise the reminders would be marked as sent without being delivered
+func Init(param InitParam) Interface {
+	switch param.Conf.Driver {
+	case DriverSMTP:
+		return initSMTP(param.Log, param.Conf.SMTP)
+	case DriverLog:
+		return initLog(param.Log)
+	default:
+		param.Log.Fatal(context.Background(), fmt.Sprintf("unknown notifier driver %q", param.Conf.Driver))
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string // Leave empty when the server does not require authentication, e.g. local SMTP stand-in
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+type smtpNotifier struct {
+	log  log.Interface
+	conf SMTPConfig
+}
+
+func initSMTP(log log.Interface, conf SMTPConfig) Interface {
+	if conf.Port == "" {
+		conf.Port = "25"
+	}
+
+	if conf.Timeout == 0 {
+		conf.Timeout = 10 * time.Second
+	}
+
+	return &smtpNotifier{
+		log:  log,
+		conf: conf,
+	}
+}
+
+func (s *smtpNotifier) Send(ctx context.Context, msg Message) error {
+	if len(msg.To) < 1 {
+		return errors.NewWithCode(codes.CodeBadRequest, "notification has no recipient")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.conf.Timeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.conf.Host, s.conf.Port))
+	if err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.conf.Host)
+	if err != nil {
+		conn.Close()
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.conf.Host}); err != nil {
+			return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+		}
+	}
+
+	if s.conf.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.conf.Username, s.conf.Password, s.conf.Host)); err != nil {
+			return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+		}
+	}
+
+	if err := client.Mail(s.conf.From); err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	for _, to := range msg.To {
+		if err := client.Rcpt(to); err != nil {
+			return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	if _, err := w.Write(s.compose(msg)); err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	if err := w.Close(); err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	return client.Quit()
+}
+
+// compose build the RFC 5322 message, the body is sent as UTF-8 plain text
+func (s *smtpNotifier) compose(msg Message) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", s.conf.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+
+	body := strings.ReplaceAll(msg.Body, "\r\n", "\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	return buf.Bytes()
+}
@@ -0,0 +1,49 @@
+package search
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Search(ctx context.Context, params entity.SearchParam) ([]entity.SearchResult, *entity.Pagination, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type search struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	s := &search{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return s
+}
+
+// Search look for the query with the FULLTEXT indexes first, then fallback to LIKE when the full text search
+// fails or finds nothing, e.g. the words are shorter than the minimum token size or only a prefix is typed
+func (s *search) Search(ctx context.Context, params entity.SearchParam) ([]entity.SearchResult, *entity.Pagination, error) {
+	results, pg, err := s.searchSQLFullText(ctx, params)
+	if err == nil && len(results) > 0 {
+		return results, pg, nil
+	} else if err != nil {
+		s.log.Warn(ctx, err)
+	}
+
+	return s.searchSQLLike(ctx, params)
+}
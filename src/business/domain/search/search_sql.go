@@ -0,0 +1,135 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/sqlutil"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (s *search) searchSQLFullText(ctx context.Context, params entity.SearchParam) ([]entity.SearchResult, *entity.Pagination, error) {
+	scope, scopeArgs := s.getTaskScope(params)
+
+	queries := []string{
+		fmt.Sprintf(searchTaskFullText, scope),
+		fmt.Sprintf(searchCommentFullText, scope),
+		fmt.Sprintf(searchCategoryFullText, s.getCategoryScope(params)),
+	}
+
+	args := []interface{}{}
+	args = append(args, params.Query)
+	args = append(args, scopeArgs...)
+	args = append(args, params.Query)
+	args = append(args, params.Query)
+	args = append(args, scopeArgs...)
+	args = append(args, params.Query)
+	args = append(args, params.Query, params.Query)
+
+	return s.searchSQL(ctx, "rSearchFullText", strings.Join(queries, "\n\t\tUNION ALL"), args, params)
+}
+
+func (s *search) searchSQLLike(ctx context.Context, params entity.SearchParam) ([]entity.SearchResult, *entity.Pagination, error) {
+	scope, scopeArgs := s.getTaskScope(params)
+	pattern := "%" + escapeLike(params.Query) + "%"
+
+	queries := []string{
+		fmt.Sprintf(searchTaskLike, scope),
+		fmt.Sprintf(searchCommentLike, scope),
+		fmt.Sprintf(searchCategoryLike, s.getCategoryScope(params)),
+	}
+
+	args := []interface{}{}
+	args = append(args, pattern)
+	args = append(args, scopeArgs...)
+	args = append(args, pattern, pattern)
+	args = append(args, scopeArgs...)
+	args = append(args, pattern)
+	args = append(args, pattern)
+
+	return s.searchSQL(ctx, "rSearchLike", strings.Join(queries, "\n\t\tUNION ALL"), args, params)
+}
+
+func (s *search) searchSQL(ctx context.Context, name, query string, args []interface{}, params entity.SearchParam) ([]entity.SearchResult, *entity.Pagination, error) {
+	results := []entity.SearchResult{}
+
+	limit, page := params.Limit, params.Page
+	if limit < 1 {
+		limit = 10
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	rows, err := s.db.Follower().Query(ctx, name, query+searchOrderLimit, append(args, limit, (page-1)*limit)...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return results, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.SearchResult{}
+		if err := rows.StructScan(&temp); err != nil {
+			s.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		results = append(results, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     page,
+		CurrentElements: int64(len(results)),
+	}
+
+	if len(results) > 0 {
+		countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s) hits", query)
+		if err := s.db.Follower().Get(ctx, "c"+strings.TrimPrefix(name, "r"), countQuery, &pg.TotalElements, args...); err != nil {
+			return results, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(limit)
+
+	return results, &pg, nil
+}
+
+// getTaskScope return the condition to limit the task and comment hits to the tasks owned by or assigned to the user
+// and the tasks of the workspaces of the user
+func (s *search) getTaskScope(params entity.SearchParam) (string, []interface{}) {
+	if !params.AccessUserID.Valid {
+		return "", nil
+	}
+
+	workspaces := ""
+	if len(params.AccessWorkspaceIDs) > 0 {
+		workspaces = fmt.Sprintf(" OR task.fk_workspace_id IN (%s)", sqlutil.JoinIDs(params.AccessWorkspaceIDs))
+	}
+
+	return fmt.Sprintf(searchTaskScope, workspaces), []interface{}{params.AccessUserID.Int64, params.AccessUserID.Int64}
+}
+
+// getCategoryScope return the condition to limit the category hits to the global categories and the categories of the
+// workspaces of the user
+func (s *search) getCategoryScope(params entity.SearchParam) string {
+	if !params.AccessUserID.Valid {
+		return ""
+	}
+
+	workspaces := ""
+	if len(params.AccessWorkspaceIDs) > 0 {
+		workspaces = fmt.Sprintf(" OR category.fk_workspace_id IN (%s)", sqlutil.JoinIDs(params.AccessWorkspaceIDs))
+	}
+
+	return fmt.Sprintf(searchCategoryScope, workspaces)
+}
+
+// escapeLike escape the LIKE wildcard so the query is matched literally
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,104 @@
+package search
+
+const (
+	// Each select take the same arguments: the query for the score, the optional scope arguments and the query for the
+	// filter. The %s placeholder is the access scope condition
+	searchTaskFullText = `
+		SELECT
+			'task' AS type,
+			task.id AS id,
+			task.id AS task_id,
+			task.title AS title,
+			task.description AS snippet,
+			MATCH(task.title, task.description) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+		FROM
+			task
+		WHERE
+			task.status = 1%s
+			AND MATCH(task.title, task.description) AGAINST (? IN NATURAL LANGUAGE MODE)`
+
+	searchCommentFullText = `
+		SELECT
+			'comment' AS type,
+			task_comment.id AS id,
+			task.id AS task_id,
+			task.title AS title,
+			task_comment.content AS snippet,
+			MATCH(task_comment.content) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+		FROM
+			task_comment
+			JOIN task ON task.id = task_comment.fk_task_id
+		WHERE
+			task_comment.status = 1
+			AND task.status = 1%s
+			AND MATCH(task_comment.content) AGAINST (? IN NATURAL LANGUAGE MODE)`
+
+	searchCategoryFullText = `
+		SELECT
+			'category' AS type,
+			category.id AS id,
+			NULL AS task_id,
+			category.name AS title,
+			'' AS snippet,
+			MATCH(category.name) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+		FROM
+			category
+		WHERE
+			category.status = 1%s
+			AND MATCH(category.name) AGAINST (? IN NATURAL LANGUAGE MODE)`
+
+	// The LIKE fallback rank a match on the title higher than a match on the content
+	searchTaskLike = `
+		SELECT
+			'task' AS type,
+			task.id AS id,
+			task.id AS task_id,
+			task.title AS title,
+			task.description AS snippet,
+			(CASE WHEN task.title LIKE ? THEN 2 ELSE 1 END) AS score
+		FROM
+			task
+		WHERE
+			task.status = 1%s
+			AND (task.title LIKE ? OR task.description LIKE ?)`
+
+	searchCommentLike = `
+		SELECT
+			'comment' AS type,
+			task_comment.id AS id,
+			task.id AS task_id,
+			task.title AS title,
+			task_comment.content AS snippet,
+			1 AS score
+		FROM
+			task_comment
+			JOIN task ON task.id = task_comment.fk_task_id
+		WHERE
+			task_comment.status = 1
+			AND task.status = 1%s
+			AND task_comment.content LIKE ?`
+
+	searchCategoryLike = `
+		SELECT
+			'category' AS type,
+			category.id AS id,
+			NULL AS task_id,
+			category.name AS title,
+			'' AS snippet,
+			2 AS score
+		FROM
+			category
+		WHERE
+			category.status = 1%s
+			AND category.name LIKE ?`
+
+	searchTaskScope = `
+			AND (task.fk_user_id = ? OR task.fk_assignee_id = ?%s)`
+
+	searchCategoryScope = `
+			AND (category.fk_workspace_id IS NULL%s)`
+
+	searchOrderLimit = `
+		ORDER BY score DESC, type, id DESC
+		LIMIT ? OFFSET ?`
+)
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+)
+
+type LocalConfig struct {
+	Path string
+}
+
+type local struct {
+	log  log.Interface
+	root string
+}
+
+func initLocal(log log.Interface, conf LocalConfig) Interface {
+	root := conf.Path
+	if root == "" {
+		root = "./storage"
+	}
+
+	return &local{
+		log:  log,
+		root: filepath.Clean(root),
+	}
+}
+
+func (l *local) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	// Write into a temporary file first so a failed upload never leave a partial file behind
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	if err := tmp.Close(); err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	l.log.Debug(ctx, fmt.Sprintf("stored file %s", key))
+
+	return nil
+}
+
+func (l *local) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, errors.NewWithCode(codes.CodeNotFound, err.Error())
+	} else if err != nil {
+		return nil, errors.NewWithCode(codes.CodeFilePathOpenFailed, err.Error())
+	}
+
+	return file, nil
+}
+
+func (l *local) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// path resolve the key into the file path and make sure it stays inside the storage root
+func (l *local) path(key string) (string, error) {
+	path := filepath.Join(l.root, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, l.root+string(filepath.Separator)) {
+		return "", errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid storage key %q", key))
+	}
+
+	return path, nil
+}
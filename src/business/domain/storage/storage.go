@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/adiatma85/own-go-sdk/log"
+)
+
+const (
+	DriverLocal = "local"
+)
+
+// Interface is the file storage backend, the key is a slash separated path relative to the storage root
+type Interface interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Driver string
+	Local  LocalConfig
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Conf Config
+}
+
+// Init return the storage backend based on the configured driver, the server does not start with an unknown driver
+func Init(param InitParam) Interface {
+	switch param.Conf.Driver {
+	case DriverLocal:
+		return initLocal(param.Log, param.Conf.Local)
+	default:
+		param.Log.Fatal(context.Background(), fmt.Sprintf("unknown storage driver %q", param.Conf.Driver))
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, tagParam entity.CreateTagParam) (entity.Tag, error)
+	Get(ctx context.Context, params entity.TagParam) (entity.Tag, error)
+	GetList(ctx context.Context, params entity.TagParam) ([]entity.Tag, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTagParam, selectParam entity.TagParam) error
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type tag struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	t := &tag{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return t
+}
+
+func (t *tag) Create(ctx context.Context, tagParam entity.CreateTagParam) (entity.Tag, error) {
+	tag := entity.Tag{}
+
+	tx, err := t.db.Leader().BeginTx(ctx, "txcTag", sql.TxOptions{})
+	if err != nil {
+		return tag, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, tag, err = t.createSQLTag(tx, tagParam)
+	if err != nil {
+		return tag, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return tag, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return t.Get(ctx, entity.TagParam{
+		ID: null.Int64From(tag.ID),
+	})
+}
+
+func (t *tag) Get(ctx context.Context, params entity.TagParam) (entity.Tag, error) {
+	return t.getSQLTag(ctx, params)
+}
+
+func (t *tag) GetList(ctx context.Context, params entity.TagParam) ([]entity.Tag, *entity.Pagination, error) {
+	return t.getSQLTagList(ctx, params)
+}
+
+func (t *tag) Update(ctx context.Context, updateParam entity.UpdateTagParam, selectParam entity.TagParam) error {
+	return t.updateSQLTag(ctx, updateParam, selectParam)
+}
@@ -0,0 +1,122 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (t *tag) createSQLTag(tx sql.CommandTx, v entity.CreateTagParam) (sql.CommandTx, entity.Tag, error) {
+	tag := entity.Tag{}
+
+	res, err := tx.NamedExec("iCreateTag", createTag, v)
+	if err != nil {
+		return tx, tag, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, tag, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, tag, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	tag.ID = lastID
+
+	return tx, tag, nil
+}
+
+func (t *tag) getSQLTag(ctx context.Context, params entity.TagParam) (entity.Tag, error) {
+	tag := entity.Tag{}
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return tag, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := t.db.Follower().QueryRow(ctx, "rTagByID", getTag+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return tag, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return tag, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&tag); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return tag, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return tag, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return tag, nil
+}
+
+func (t *tag) getSQLTagList(ctx context.Context, params entity.TagParam) ([]entity.Tag, *entity.Pagination, error) {
+	tags := []entity.Tag{}
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return tags, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := t.db.Follower().Query(ctx, "rListTag", getTag+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return tags, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.Tag{}
+		if err := rows.StructScan(&temp); err != nil {
+			t.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		tags = append(tags, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(tags)),
+	}
+
+	if len(tags) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := t.db.Follower().Get(ctx, "cTag", readTagCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return tags, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return tags, &pg, nil
+}
+
+func (t *tag) updateSQLTag(ctx context.Context, updateParam entity.UpdateTagParam, selectParam entity.TagParam) error {
+	t.log.Debug(ctx, fmt.Sprintf("update tag by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = t.db.Leader().Exec(ctx, "uTag", updateTag+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	t.log.Debug(ctx, fmt.Sprintf("successfully updated tag: %v", updateParam))
+
+	return nil
+}
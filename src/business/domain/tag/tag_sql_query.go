@@ -0,0 +1,30 @@
+package tag
+
+const (
+	createTag = `
+	INSERT INTO tag (fk_user_id, name, created_by, updated_by)
+	    VALUES (:fk_user_id, :name, :created_by, :updated_by)`
+
+	getTag = `
+		SELECT
+			id,
+			fk_user_id,
+			name,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			tag`
+
+	updateTag = `
+	UPDATE
+		tag`
+
+	readTagCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			tag`
+)
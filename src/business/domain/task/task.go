@@ -2,7 +2,10 @@ package task
 
 import (
 	"context"
+	"strings"
 
+	categoryDom "github.com/adiatma85/gg-project/src/business/domain/category"
+	taskStatusHistoryDom "github.com/adiatma85/gg-project/src/business/domain/taskstatushistory"
 	"github.com/adiatma85/gg-project/src/business/entity"
 	"github.com/adiatma85/own-go-sdk/codes"
 	"github.com/adiatma85/own-go-sdk/errors"
@@ -17,25 +20,37 @@ type Interface interface {
 	Get(ctx context.Context, params entity.TaskParam) (entity.Task, error)
 	GetList(ctx context.Context, params entity.TaskParam) ([]entity.Task, *entity.Pagination, error)
 	Update(ctx context.Context, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam) error
+	UpdateStatus(ctx context.Context, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam, historyParam entity.CreateTaskStatusHistoryParam, fn func(tx sql.CommandTx) (sql.CommandTx, error)) error
+	UpdateBulk(ctx context.Context, updates []entity.TaskBulkUpdate) error
+	UpdateBulkLocked(ctx context.Context, params entity.TaskParam, fn func(tasks []entity.Task) ([]entity.TaskBulkUpdate, []entity.CreateTaskStatusHistoryParam, error)) error
+	UpdateAssignee(ctx context.Context, taskID int64, assigneeID null.Int64, updatedBy null.String) error
+	Stream(ctx context.Context, params entity.TaskParam, fn func(entity.Task) error) error
+	Import(ctx context.Context, categories []entity.CreateCategoryParam, rows []entity.TaskImportRow) ([]entity.Task, error)
 }
 
 type InitParam struct {
-	Log  log.Interface
-	Db   sql.Interface
-	Json parser.JSONInterface
+	Log               log.Interface
+	Db                sql.Interface
+	Json              parser.JSONInterface
+	Category          categoryDom.Interface
+	TaskStatusHistory taskStatusHistoryDom.Interface
 }
 
 type task struct {
-	log  log.Interface
-	db   sql.Interface
-	json parser.JSONInterface
+	log               log.Interface
+	db                sql.Interface
+	json              parser.JSONInterface
+	category          categoryDom.Interface
+	taskStatusHistory taskStatusHistoryDom.Interface
 }
 
 func Init(param InitParam) Interface {
 	t := &task{
-		log:  param.Log,
-		db:   param.Db,
-		json: param.Json,
+		log:               param.Log,
+		db:                param.Db,
+		json:              param.Json,
+		category:          param.Category,
+		taskStatusHistory: param.TaskStatusHistory,
 	}
 
 	return t
@@ -75,3 +90,152 @@ func (t *task) GetList(ctx context.Context, params entity.TaskParam) ([]entity.T
 func (t *task) Update(ctx context.Context, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam) error {
 	return t.updateSQLTask(ctx, updateParam, selectParam)
 }
+
+// UpdateStatus update the task only when it is still in the status of selectParam, the task changed by another request
+// in the meantime returns a conflict. The transition is recorded and fn, when given, runs in the same transaction so
+// the writes which depend on the new status are committed together with it
+func (t *task) UpdateStatus(ctx context.Context, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam, historyParam entity.CreateTaskStatusHistoryParam, fn func(tx sql.CommandTx) (sql.CommandTx, error)) error {
+	tx, err := t.db.Leader().BeginTx(ctx, "txuTaskStatus", sql.TxOptions{})
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, err = t.updateSQLTaskStatus(ctx, tx, updateParam, selectParam)
+	if err != nil {
+		return err
+	}
+
+	tx, _, err = t.taskStatusHistory.CreateTx(tx, historyParam)
+	if err != nil {
+		return err
+	}
+
+	if fn != nil {
+		tx, err = fn(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return nil
+}
+
+func (t *task) UpdateBulk(ctx context.Context, updates []entity.TaskBulkUpdate) error {
+	tx, err := t.db.Leader().BeginTx(ctx, "txuBulkTask", sql.TxOptions{})
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	for _, u := range updates {
+		tx, err = t.updateSQLTaskTx(ctx, tx, u.UpdateParam, u.SelectParam)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return nil
+}
+
+// UpdateBulkLocked lock the tasks of the params until the transaction ends and apply the updates and the status
+// history returned by fn, so fn decides the updates from the state which can not be changed by another request
+func (t *task) UpdateBulkLocked(ctx context.Context, params entity.TaskParam, fn func(tasks []entity.Task) ([]entity.TaskBulkUpdate, []entity.CreateTaskStatusHistoryParam, error)) error {
+	tx, err := t.db.Leader().BeginTx(ctx, "txuBulkLockedTask", sql.TxOptions{})
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, tasks, err := t.getSQLTaskListForUpdate(ctx, tx, params)
+	if err != nil {
+		return err
+	}
+
+	updates, histories, err := fn(tasks)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range updates {
+		tx, err = t.updateSQLTaskTx(ctx, tx, u.UpdateParam, u.SelectParam)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, history := range histories {
+		tx, _, err = t.taskStatusHistory.CreateTx(tx, history)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return nil
+}
+
+// UpdateAssignee set or clear the assignee of the task, the query builder can not set a column to NULL
+func (t *task) UpdateAssignee(ctx context.Context, taskID int64, assigneeID null.Int64, updatedBy null.String) error {
+	return t.updateSQLTaskAssignee(ctx, taskID, assigneeID, updatedBy)
+}
+
+// Stream read the tasks one by one without loading the whole list into memory, it stops at the first error of fn
+func (t *task) Stream(ctx context.Context, params entity.TaskParam, fn func(entity.Task) error) error {
+	return t.streamSQLTask(ctx, params, fn)
+}
+
+// Import create the missing categories and the tasks in one transaction, task of a new category is linked by the
+// category name
+func (t *task) Import(ctx context.Context, categories []entity.CreateCategoryParam, rows []entity.TaskImportRow) ([]entity.Task, error) {
+	result := []entity.Task{}
+
+	tx, err := t.db.Leader().BeginTx(ctx, "txcImportTask", sql.TxOptions{})
+	if err != nil {
+		return result, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	categoryIDs := map[string]int64{}
+	for _, c := range categories {
+		var category entity.Category
+		tx, category, err = t.category.CreateTx(tx, c)
+		if err != nil {
+			return result, err
+		}
+		categoryIDs[strings.ToLower(c.Name)] = category.ID
+	}
+
+	for _, row := range rows {
+		if id, ok := categoryIDs[strings.ToLower(row.Category)]; ok && row.Task.CategoryID == 0 {
+			row.Task.CategoryID = id
+		}
+
+		var task entity.Task
+		tx, task, err = t.createSQLTask(tx, row.Task)
+		if err != nil {
+			return result, err
+		}
+
+		task.UserId = row.Task.UserId
+		task.TaskStatus = row.Task.TaskStatus
+		result = append(result, task)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return result, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return result, nil
+}
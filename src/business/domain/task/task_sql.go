@@ -3,19 +3,29 @@ package task
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/sqlutil"
 	"github.com/adiatma85/own-go-sdk/codes"
 	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
 	"github.com/adiatma85/own-go-sdk/query"
 	"github.com/adiatma85/own-go-sdk/sql"
 )
 
+// Unique index of the occurrences of a series, a series has one occurrence per due time
+const uniqueSeriesDueTime = "uk_task_fk_series_id_due_time"
+
 func (t *task) createSQLTask(tx sql.CommandTx, v entity.CreateTaskParam) (sql.CommandTx, entity.Task, error) {
 	task := entity.Task{}
 
 	res, err := tx.NamedExec("iCreateTask", createTask, v)
-	if err != nil {
+	if sqlutil.IsDuplicateEntry(err, uniqueSeriesDueTime) {
+		return tx, task, errors.NewWithCode(codes.CodeConflict, "occurrence of the series already exists")
+	} else if sqlutil.IsDuplicateEntry(err) {
+		return tx, task, errors.NewWithCode(codes.CodeConflict, "task already exists")
+	} else if err != nil {
 		return tx, task, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
 	}
 
@@ -38,6 +48,7 @@ func (t *task) getSQLTask(ctx context.Context, params entity.TaskParam) (entity.
 	result := entity.Task{}
 
 	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &params.QueryOption)
+	qb.AddPrefixQuery(t.getFilterQuery(params))
 	queryExt, queryArgs, _, _, err := qb.Build(&params)
 	if err != nil {
 		return result, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
@@ -56,6 +67,8 @@ func (t *task) getSQLTask(ctx context.Context, params entity.TaskParam) (entity.
 		return result, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
 	}
 
+	result.ProcessCompletion()
+
 	return result, nil
 }
 
@@ -63,6 +76,7 @@ func (t *task) getSQLTaskList(ctx context.Context, params entity.TaskParam) ([]e
 	results := []entity.Task{}
 
 	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &params.QueryOption)
+	qb.AddPrefixQuery(t.getFilterQuery(params))
 	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
 	if err != nil {
 		return results, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
@@ -81,6 +95,7 @@ func (t *task) getSQLTaskList(ctx context.Context, params entity.TaskParam) ([]e
 			t.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
 			continue
 		}
+		temp.ProcessCompletion()
 		results = append(results, temp)
 	}
 
@@ -100,6 +115,69 @@ func (t *task) getSQLTaskList(ctx context.Context, params entity.TaskParam) ([]e
 	return results, &pg, nil
 }
 
+// getSQLTaskListForUpdate read the tasks of the params with a locking read, the tasks are locked in the order of their
+// id so two requests locking the same tasks do not deadlock
+func (t *task) getSQLTaskListForUpdate(ctx context.Context, tx sql.CommandTx, params entity.TaskParam) (sql.CommandTx, []entity.Task, error) {
+	results := []entity.Task{}
+
+	params.SortBy = []string{"id"}
+	params.QueryOption.DisableLimit = true
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &params.QueryOption)
+	qb.AddPrefixQuery(t.getFilterQuery(params))
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return tx, results, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	if err := tx.Select("rListTaskForUpdate", getTask+queryExt+forUpdate, &results, queryArgs...); err != nil {
+		return tx, results, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	for i := range results {
+		results[i].ProcessCompletion()
+	}
+
+	return tx, results, nil
+}
+
+func (t *task) streamSQLTask(ctx context.Context, params entity.TaskParam, fn func(entity.Task) error) error {
+	params.QueryOption.DisableLimit = true
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &params.QueryOption)
+	qb.AddPrefixQuery(t.getFilterQuery(params))
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := t.db.Follower().Query(ctx, "rStreamTask", getTask+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.Task{}
+		if err := rows.StructScan(&temp); err != nil {
+			t.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		temp.ProcessCompletion()
+
+		if err := fn(temp); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	return nil
+}
+
 func (t *task) updateSQLTask(ctx context.Context, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam) error {
 	t.log.Debug(ctx, fmt.Sprintf("update task by: %v", selectParam))
 
@@ -120,3 +198,103 @@ func (t *task) updateSQLTask(ctx context.Context, updateParam entity.UpdateTaskP
 
 	return nil
 }
+
+func (t *task) updateSQLTaskStatus(ctx context.Context, tx sql.CommandTx, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam) (sql.CommandTx, error) {
+	t.log.Debug(ctx, fmt.Sprintf("update status of task by: %v", selectParam))
+
+	if selectParam.TaskStatus == "" {
+		return tx, errors.NewWithCode(codes.CodeSQLBuilder, "current task status is required")
+	}
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	res, err := tx.Exec("uTaskStatus", updateTask+queryUpdate, args...)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return tx, errors.NewWithCode(codes.CodeConflict, "task status was changed by another request")
+	}
+
+	t.log.Debug(ctx, fmt.Sprintf("successfully updated status of task: %v", updateParam))
+
+	return tx, nil
+}
+
+// updateSQLTaskAssignee set the assignee of the task, the invalid assignee unassign the task
+func (t *task) updateSQLTaskAssignee(ctx context.Context, taskID int64, assigneeID null.Int64, updatedBy null.String) error {
+	t.log.Debug(ctx, fmt.Sprintf("update assignee of task %d to: %v", taskID, assigneeID))
+
+	_, err := t.db.Leader().Exec(ctx, "uTaskAssignee", updateTaskAssignee, assigneeID, updatedBy, taskID)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	t.log.Debug(ctx, fmt.Sprintf("successfully updated assignee of task: %d", taskID))
+
+	return nil
+}
+
+// getFilterQuery return the filters that can not be expressed with the param tag
+func (t *task) getFilterQuery(params entity.TaskParam) string {
+	filters := []string{}
+
+	if params.TopLevelOnly {
+		filters = append(filters, "fk_parent_task_id IS NULL")
+	}
+
+	if params.SeriesOnly {
+		filters = append(filters, "fk_series_id IS NULL")
+	}
+
+	if params.PersonalOnly {
+		filters = append(filters, "fk_workspace_id IS NULL")
+	}
+
+	if params.RecurringOnly {
+		filters = append(filters, fmt.Sprintf("(rrule <> '' OR periodic IN ('%s', '%s', '%s', '%s'))",
+			entity.TaskPeriodicDaily, entity.TaskPeriodicWeekly, entity.TaskPeriodicMonthly, entity.TaskPeriodicYearly))
+	}
+
+	if params.AccessUserID.Valid {
+		access := []string{
+			fmt.Sprintf("fk_user_id = %d", params.AccessUserID.Int64),
+			fmt.Sprintf("fk_assignee_id = %d", params.AccessUserID.Int64),
+		}
+		if len(params.AccessWorkspaceIDs) > 0 {
+			access = append(access, fmt.Sprintf("fk_workspace_id IN (%s)", sqlutil.JoinIDs(params.AccessWorkspaceIDs)))
+		}
+		filters = append(filters, "("+strings.Join(access, " OR ")+")")
+	}
+
+	return strings.Join(filters, " AND ")
+}
+
+func (t *task) updateSQLTaskTx(ctx context.Context, tx sql.CommandTx, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam) (sql.CommandTx, error) {
+	t.log.Debug(ctx, fmt.Sprintf("update task by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(t.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = tx.Exec("uTaskTx", updateTask+queryUpdate, args...)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	t.log.Debug(ctx, fmt.Sprintf("successfully updated task: %v", updateParam))
+
+	return tx, nil
+}
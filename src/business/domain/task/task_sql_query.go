@@ -1,19 +1,29 @@
 package task
 
 const (
-	createTask = `INSERT INTO task (fk_user_id, fk_category_id, title, priority, task_status, periodic, due_time, created_by, updated_by)
-	VALUES (:fk_user_id, :fk_category_id, :title, :priority, :task_status, :periodic, :due_time, :created_by, :updated_by)`
+	createTask = `INSERT INTO task (fk_user_id, fk_assignee_id, fk_workspace_id, fk_category_id, fk_series_id, fk_parent_task_id, title, description, priority, task_status, periodic, rrule, due_time, auto_complete, position, created_by, updated_by)
+	VALUES (:fk_user_id, :fk_assignee_id, :fk_workspace_id, :fk_category_id, :fk_series_id, :fk_parent_task_id, :title, :description, :priority, :task_status, :periodic, :rrule, :due_time, :auto_complete, :position, :created_by, :updated_by)`
 
 	getTask = `
 		SELECT
 			id,
 			fk_user_id,
+			fk_assignee_id,
+			fk_workspace_id,
 			fk_category_id,
+			fk_series_id,
+			fk_parent_task_id,
 			title,
+			description,
 			priority,
 			task_status,
 			periodic,
-			due_time,			
+			rrule,
+			due_time,
+			auto_complete,
+			position,
+			(SELECT COUNT(*) FROM task subtask WHERE subtask.fk_parent_task_id = task.id AND subtask.status = 1) AS subtask_count,
+			(SELECT COUNT(*) FROM task subtask WHERE subtask.fk_parent_task_id = task.id AND subtask.status = 1 AND subtask.task_status = 'done') AS subtask_done_count,
 			status,
 			created_at,
 			created_by,
@@ -22,10 +32,23 @@ const (
 		FROM
 			task`
 
+	// The subqueries of getTask count the subtasks without locking them
+	forUpdate = `
+		FOR UPDATE`
+
 	updateTask = `
 	UPDATE
 		task`
 
+	updateTaskAssignee = `
+	UPDATE
+		task
+	SET
+		fk_assignee_id = ?,
+		updated_by = ?
+	WHERE
+		id = ?`
+
 	readTaskCount = `
 	SELECT
 			COUNT(*)
@@ -0,0 +1,77 @@
+package taskdependency
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, dependencyParam entity.CreateTaskDependencyParam) (entity.TaskDependency, error)
+	Get(ctx context.Context, params entity.TaskDependencyParam) (entity.TaskDependency, error)
+	GetList(ctx context.Context, params entity.TaskDependencyParam) ([]entity.TaskDependency, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTaskDependencyParam, selectParam entity.TaskDependencyParam) error
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type taskDependency struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	td := &taskDependency{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return td
+}
+
+func (td *taskDependency) Create(ctx context.Context, dependencyParam entity.CreateTaskDependencyParam) (entity.TaskDependency, error) {
+	dependency := entity.TaskDependency{}
+
+	tx, err := td.db.Leader().BeginTx(ctx, "txcTaskDependency", sql.TxOptions{})
+	if err != nil {
+		return dependency, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, dependency, err = td.createSQLTaskDependency(tx, dependencyParam)
+	if err != nil {
+		return dependency, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return dependency, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return td.Get(ctx, entity.TaskDependencyParam{
+		ID: null.Int64From(dependency.ID),
+	})
+}
+
+func (td *taskDependency) Get(ctx context.Context, params entity.TaskDependencyParam) (entity.TaskDependency, error) {
+	return td.getSQLTaskDependency(ctx, params)
+}
+
+func (td *taskDependency) GetList(ctx context.Context, params entity.TaskDependencyParam) ([]entity.TaskDependency, *entity.Pagination, error) {
+	return td.getSQLTaskDependencyList(ctx, params)
+}
+
+func (td *taskDependency) Update(ctx context.Context, updateParam entity.UpdateTaskDependencyParam, selectParam entity.TaskDependencyParam) error {
+	return td.updateSQLTaskDependency(ctx, updateParam, selectParam)
+}
@@ -0,0 +1,125 @@
+package taskdependency
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/sqlutil"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (td *taskDependency) createSQLTaskDependency(tx sql.CommandTx, v entity.CreateTaskDependencyParam) (sql.CommandTx, entity.TaskDependency, error) {
+	dependency := entity.TaskDependency{}
+
+	res, err := tx.NamedExec("iCreateTaskDependency", createTaskDependency, v)
+	if sqlutil.IsDuplicateEntry(err) {
+		return tx, dependency, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("task %d is already blocked by task %d", v.TaskID, v.BlockerTaskID))
+	} else if err != nil {
+		return tx, dependency, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, dependency, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, dependency, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	dependency.ID = lastID
+
+	return tx, dependency, nil
+}
+
+func (td *taskDependency) getSQLTaskDependency(ctx context.Context, params entity.TaskDependencyParam) (entity.TaskDependency, error) {
+	dependency := entity.TaskDependency{}
+
+	qb := query.NewSQLQueryBuilder(td.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return dependency, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := td.db.Follower().QueryRow(ctx, "rTaskDependencyByID", getTaskDependency+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return dependency, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return dependency, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&dependency); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return dependency, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return dependency, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return dependency, nil
+}
+
+func (td *taskDependency) getSQLTaskDependencyList(ctx context.Context, params entity.TaskDependencyParam) ([]entity.TaskDependency, *entity.Pagination, error) {
+	dependencies := []entity.TaskDependency{}
+
+	qb := query.NewSQLQueryBuilder(td.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return dependencies, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := td.db.Follower().Query(ctx, "rListTaskDependency", getTaskDependency+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return dependencies, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TaskDependency{}
+		if err := rows.StructScan(&temp); err != nil {
+			td.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		dependencies = append(dependencies, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(dependencies)),
+	}
+
+	if len(dependencies) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := td.db.Follower().Get(ctx, "cTaskDependency", readTaskDependencyCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return dependencies, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return dependencies, &pg, nil
+}
+
+func (td *taskDependency) updateSQLTaskDependency(ctx context.Context, updateParam entity.UpdateTaskDependencyParam, selectParam entity.TaskDependencyParam) error {
+	td.log.Debug(ctx, fmt.Sprintf("update task dependency by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(td.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = td.db.Leader().Exec(ctx, "uTaskDependency", updateTaskDependency+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	td.log.Debug(ctx, fmt.Sprintf("successfully updated task dependency: %v", updateParam))
+
+	return nil
+}
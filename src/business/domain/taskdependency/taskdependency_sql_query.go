@@ -0,0 +1,30 @@
+package taskdependency
+
+const (
+	createTaskDependency = `
+	INSERT INTO task_dependency (fk_task_id, fk_blocker_task_id, created_by, updated_by)
+	    VALUES (:fk_task_id, :fk_blocker_task_id, :created_by, :updated_by)`
+
+	getTaskDependency = `
+		SELECT
+			id,
+			fk_task_id,
+			fk_blocker_task_id,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			task_dependency`
+
+	updateTaskDependency = `
+	UPDATE
+		task_dependency`
+
+	readTaskDependencyCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			task_dependency`
+)
@@ -0,0 +1,83 @@
+package taskreminder
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, reminderParam entity.CreateTaskReminderParam) (entity.TaskReminder, error)
+	Get(ctx context.Context, params entity.TaskReminderParam) (entity.TaskReminder, error)
+	GetList(ctx context.Context, params entity.TaskReminderParam) ([]entity.TaskReminder, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTaskReminderParam, selectParam entity.TaskReminderParam) error
+	Claim(ctx context.Context, param entity.ClaimTaskReminderParam) ([]entity.TaskReminder, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type taskReminder struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	tr := &taskReminder{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return tr
+}
+
+func (tr *taskReminder) Create(ctx context.Context, reminderParam entity.CreateTaskReminderParam) (entity.TaskReminder, error) {
+	reminder := entity.TaskReminder{}
+
+	tx, err := tr.db.Leader().BeginTx(ctx, "txcTaskReminder", sql.TxOptions{})
+	if err != nil {
+		return reminder, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, reminder, err = tr.createSQLTaskReminder(tx, reminderParam)
+	if err != nil {
+		return reminder, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return reminder, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return tr.Get(ctx, entity.TaskReminderParam{
+		ID: null.Int64From(reminder.ID),
+	})
+}
+
+func (tr *taskReminder) Get(ctx context.Context, params entity.TaskReminderParam) (entity.TaskReminder, error) {
+	return tr.getSQLTaskReminder(ctx, params)
+}
+
+func (tr *taskReminder) GetList(ctx context.Context, params entity.TaskReminderParam) ([]entity.TaskReminder, *entity.Pagination, error) {
+	return tr.getSQLTaskReminderList(ctx, params)
+}
+
+func (tr *taskReminder) Update(ctx context.Context, updateParam entity.UpdateTaskReminderParam, selectParam entity.TaskReminderParam) error {
+	return tr.updateSQLTaskReminder(ctx, updateParam, selectParam)
+}
+
+// Claim mark the due reminders with the claim token and return the claimed reminders
+func (tr *taskReminder) Claim(ctx context.Context, param entity.ClaimTaskReminderParam) ([]entity.TaskReminder, error) {
+	return tr.claimSQLTaskReminder(ctx, param)
+}
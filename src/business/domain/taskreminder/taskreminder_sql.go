@@ -0,0 +1,163 @@
+package taskreminder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (tr *taskReminder) createSQLTaskReminder(tx sql.CommandTx, v entity.CreateTaskReminderParam) (sql.CommandTx, entity.TaskReminder, error) {
+	reminder := entity.TaskReminder{}
+
+	res, err := tx.NamedExec("iCreateTaskReminder", createTaskReminder, v)
+	if err != nil {
+		return tx, reminder, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, reminder, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, reminder, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	reminder.ID = lastID
+
+	return tx, reminder, nil
+}
+
+func (tr *taskReminder) getSQLTaskReminder(ctx context.Context, params entity.TaskReminderParam) (entity.TaskReminder, error) {
+	reminder := entity.TaskReminder{}
+
+	qb := query.NewSQLQueryBuilder(tr.db, "param", "db", &params.QueryOption)
+	if params.Unsent {
+		qb.AddPrefixQuery("sent_at IS NULL")
+	}
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return reminder, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := tr.db.Follower().QueryRow(ctx, "rTaskReminderByID", getTaskReminder+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return reminder, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return reminder, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&reminder); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return reminder, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return reminder, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return reminder, nil
+}
+
+func (tr *taskReminder) getSQLTaskReminderList(ctx context.Context, params entity.TaskReminderParam) ([]entity.TaskReminder, *entity.Pagination, error) {
+	reminders := []entity.TaskReminder{}
+
+	qb := query.NewSQLQueryBuilder(tr.db, "param", "db", &params.QueryOption)
+	if params.Unsent {
+		qb.AddPrefixQuery("sent_at IS NULL")
+	}
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return reminders, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := tr.db.Follower().Query(ctx, "rListTaskReminder", getTaskReminder+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return reminders, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TaskReminder{}
+		if err := rows.StructScan(&temp); err != nil {
+			tr.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		reminders = append(reminders, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(reminders)),
+	}
+
+	if len(reminders) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := tr.db.Follower().Get(ctx, "cTaskReminder", readTaskReminderCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return reminders, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return reminders, &pg, nil
+}
+
+func (tr *taskReminder) updateSQLTaskReminder(ctx context.Context, updateParam entity.UpdateTaskReminderParam, selectParam entity.TaskReminderParam) error {
+	tr.log.Debug(ctx, fmt.Sprintf("update task reminder by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(tr.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = tr.db.Leader().Exec(ctx, "uTaskReminder", updateTaskReminder+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	tr.log.Debug(ctx, fmt.Sprintf("successfully updated task reminder: %v", updateParam))
+
+	return nil
+}
+
+func (tr *taskReminder) claimSQLTaskReminder(ctx context.Context, param entity.ClaimTaskReminderParam) ([]entity.TaskReminder, error) {
+	reminders := []entity.TaskReminder{}
+
+	res, err := tr.db.Leader().Exec(ctx, "uClaimTaskReminder", claimTaskReminder,
+		param.ClaimToken, param.Now, param.Now, param.MaxAttempts, param.Now.Add(-param.ClaimTimeout), param.Limit)
+	if err != nil {
+		return reminders, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return reminders, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	} else if rowCount < 1 {
+		return reminders, nil
+	}
+
+	rows, err := tr.db.Leader().Query(ctx, "rListClaimedTaskReminder", getTaskReminder+getClaimedTaskReminder, param.ClaimToken)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return reminders, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TaskReminder{}
+		if err := rows.StructScan(&temp); err != nil {
+			tr.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		reminders = append(reminders, temp)
+	}
+
+	return reminders, nil
+}
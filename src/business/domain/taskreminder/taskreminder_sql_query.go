@@ -0,0 +1,67 @@
+package taskreminder
+
+const (
+	createTaskReminder = `
+	INSERT INTO task_reminder (fk_task_id, fk_user_id, kind, offset_minutes, time_of_day, timezone, remind_at, created_by, updated_by)
+	    VALUES (:fk_task_id, :fk_user_id, :kind, :offset_minutes, :time_of_day, :timezone, :remind_at, :created_by, :updated_by)`
+
+	getTaskReminder = `
+		SELECT
+			id,
+			fk_task_id,
+			fk_user_id,
+			kind,
+			offset_minutes,
+			time_of_day,
+			timezone,
+			remind_at,
+			sent_at,
+			claim_token,
+			claimed_at,
+			attempts,
+			last_error,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			task_reminder`
+
+	updateTaskReminder = `
+	UPDATE
+		task_reminder`
+
+	// Claim is a single statement so only one worker can own a reminder, a claim older than the timeout
+	// belongs to a worker which died before it finished and can be taken over
+	claimTaskReminder = `
+	UPDATE
+		task_reminder
+	SET
+		claim_token = ?,
+		claimed_at = ?,
+		attempts = attempts + 1
+	WHERE
+		status = 1
+		AND sent_at IS NULL
+		AND remind_at IS NOT NULL
+		AND remind_at <= ?
+		AND attempts < ?
+		AND (claim_token = '' OR claimed_at < ?)
+	ORDER BY
+		remind_at
+	LIMIT ?`
+
+	// Claimed reminders are read back from the leader, the follower may not have the claim yet
+	getClaimedTaskReminder = `
+		WHERE
+			claim_token = ?
+			AND sent_at IS NULL
+			AND status = 1`
+
+	readTaskReminderCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			task_reminder`
+)
@@ -0,0 +1,78 @@
+package taskstatushistory
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, historyParam entity.CreateTaskStatusHistoryParam) (entity.TaskStatusHistory, error)
+	Get(ctx context.Context, params entity.TaskStatusHistoryParam) (entity.TaskStatusHistory, error)
+	GetList(ctx context.Context, params entity.TaskStatusHistoryParam) ([]entity.TaskStatusHistory, *entity.Pagination, error)
+	CreateTx(tx sql.CommandTx, historyParam entity.CreateTaskStatusHistoryParam) (sql.CommandTx, entity.TaskStatusHistory, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type taskStatusHistory struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	tsh := &taskStatusHistory{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return tsh
+}
+
+func (tsh *taskStatusHistory) Create(ctx context.Context, historyParam entity.CreateTaskStatusHistoryParam) (entity.TaskStatusHistory, error) {
+	history := entity.TaskStatusHistory{}
+
+	tx, err := tsh.db.Leader().BeginTx(ctx, "txcTaskStatusHistory", sql.TxOptions{})
+	if err != nil {
+		return history, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, history, err = tsh.createSQLTaskStatusHistory(tx, historyParam)
+	if err != nil {
+		return history, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return history, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return tsh.Get(ctx, entity.TaskStatusHistoryParam{
+		ID: null.Int64From(history.ID),
+	})
+}
+
+func (tsh *taskStatusHistory) Get(ctx context.Context, params entity.TaskStatusHistoryParam) (entity.TaskStatusHistory, error) {
+	return tsh.getSQLTaskStatusHistory(ctx, params)
+}
+
+func (tsh *taskStatusHistory) GetList(ctx context.Context, params entity.TaskStatusHistoryParam) ([]entity.TaskStatusHistory, *entity.Pagination, error) {
+	return tsh.getSQLTaskStatusHistoryList(ctx, params)
+}
+
+// CreateTx record the transition inside the transaction owned by the caller
+func (tsh *taskStatusHistory) CreateTx(tx sql.CommandTx, historyParam entity.CreateTaskStatusHistoryParam) (sql.CommandTx, entity.TaskStatusHistory, error) {
+	return tsh.createSQLTaskStatusHistory(tx, historyParam)
+}
@@ -0,0 +1,100 @@
+package taskstatushistory
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (tsh *taskStatusHistory) createSQLTaskStatusHistory(tx sql.CommandTx, v entity.CreateTaskStatusHistoryParam) (sql.CommandTx, entity.TaskStatusHistory, error) {
+	history := entity.TaskStatusHistory{}
+
+	res, err := tx.NamedExec("iCreateTaskStatusHistory", createTaskStatusHistory, v)
+	if err != nil {
+		return tx, history, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, history, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, history, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	history.ID = lastID
+
+	return tx, history, nil
+}
+
+func (tsh *taskStatusHistory) getSQLTaskStatusHistory(ctx context.Context, params entity.TaskStatusHistoryParam) (entity.TaskStatusHistory, error) {
+	history := entity.TaskStatusHistory{}
+
+	qb := query.NewSQLQueryBuilder(tsh.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return history, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := tsh.db.Follower().QueryRow(ctx, "rTaskStatusHistoryByID", getTaskStatusHistory+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return history, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return history, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&history); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return history, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return history, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return history, nil
+}
+
+func (tsh *taskStatusHistory) getSQLTaskStatusHistoryList(ctx context.Context, params entity.TaskStatusHistoryParam) ([]entity.TaskStatusHistory, *entity.Pagination, error) {
+	histories := []entity.TaskStatusHistory{}
+
+	qb := query.NewSQLQueryBuilder(tsh.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return histories, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := tsh.db.Follower().Query(ctx, "rListTaskStatusHistory", getTaskStatusHistory+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return histories, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TaskStatusHistory{}
+		if err := rows.StructScan(&temp); err != nil {
+			tsh.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		histories = append(histories, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(histories)),
+	}
+
+	if len(histories) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := tsh.db.Follower().Get(ctx, "cTaskStatusHistory", readTaskStatusHistoryCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return histories, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return histories, &pg, nil
+}
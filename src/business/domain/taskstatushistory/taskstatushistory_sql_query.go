@@ -0,0 +1,25 @@
+package taskstatushistory
+
+const (
+	createTaskStatusHistory = `
+	INSERT INTO task_status_history (fk_task_id, from_status, to_status, created_by)
+	    VALUES (:fk_task_id, :from_status, :to_status, :created_by)`
+
+	getTaskStatusHistory = `
+		SELECT
+			id,
+			fk_task_id,
+			from_status,
+			to_status,
+			status,
+			created_at,
+			created_by
+		FROM
+			task_status_history`
+
+	readTaskStatusHistoryCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			task_status_history`
+)
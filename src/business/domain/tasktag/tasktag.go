@@ -0,0 +1,77 @@
+package tasktag
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, taskTagParam entity.CreateTaskTagParam) (entity.TaskTag, error)
+	Get(ctx context.Context, params entity.TaskTagParam) (entity.TaskTag, error)
+	GetList(ctx context.Context, params entity.TaskTagParam) ([]entity.TaskTag, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTaskTagParam, selectParam entity.TaskTagParam) error
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type taskTag struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	tt := &taskTag{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return tt
+}
+
+func (tt *taskTag) Create(ctx context.Context, taskTagParam entity.CreateTaskTagParam) (entity.TaskTag, error) {
+	taskTag := entity.TaskTag{}
+
+	tx, err := tt.db.Leader().BeginTx(ctx, "txcTaskTag", sql.TxOptions{})
+	if err != nil {
+		return taskTag, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, taskTag, err = tt.createSQLTaskTag(tx, taskTagParam)
+	if err != nil {
+		return taskTag, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return taskTag, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return tt.Get(ctx, entity.TaskTagParam{
+		ID: null.Int64From(taskTag.ID),
+	})
+}
+
+func (tt *taskTag) Get(ctx context.Context, params entity.TaskTagParam) (entity.TaskTag, error) {
+	return tt.getSQLTaskTag(ctx, params)
+}
+
+func (tt *taskTag) GetList(ctx context.Context, params entity.TaskTagParam) ([]entity.TaskTag, *entity.Pagination, error) {
+	return tt.getSQLTaskTagList(ctx, params)
+}
+
+func (tt *taskTag) Update(ctx context.Context, updateParam entity.UpdateTaskTagParam, selectParam entity.TaskTagParam) error {
+	return tt.updateSQLTaskTag(ctx, updateParam, selectParam)
+}
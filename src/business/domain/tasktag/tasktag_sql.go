@@ -0,0 +1,122 @@
+package tasktag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (tt *taskTag) createSQLTaskTag(tx sql.CommandTx, v entity.CreateTaskTagParam) (sql.CommandTx, entity.TaskTag, error) {
+	taskTag := entity.TaskTag{}
+
+	res, err := tx.NamedExec("iCreateTaskTag", createTaskTag, v)
+	if err != nil {
+		return tx, taskTag, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, taskTag, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, taskTag, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	taskTag.ID = lastID
+
+	return tx, taskTag, nil
+}
+
+func (tt *taskTag) getSQLTaskTag(ctx context.Context, params entity.TaskTagParam) (entity.TaskTag, error) {
+	taskTag := entity.TaskTag{}
+
+	qb := query.NewSQLQueryBuilder(tt.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return taskTag, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := tt.db.Follower().QueryRow(ctx, "rTaskTagByID", getTaskTag+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return taskTag, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return taskTag, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&taskTag); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return taskTag, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return taskTag, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return taskTag, nil
+}
+
+func (tt *taskTag) getSQLTaskTagList(ctx context.Context, params entity.TaskTagParam) ([]entity.TaskTag, *entity.Pagination, error) {
+	taskTags := []entity.TaskTag{}
+
+	qb := query.NewSQLQueryBuilder(tt.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return taskTags, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := tt.db.Follower().Query(ctx, "rListTaskTag", getTaskTag+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return taskTags, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TaskTag{}
+		if err := rows.StructScan(&temp); err != nil {
+			tt.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		taskTags = append(taskTags, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(taskTags)),
+	}
+
+	if len(taskTags) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := tt.db.Follower().Get(ctx, "cTaskTag", readTaskTagCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return taskTags, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return taskTags, &pg, nil
+}
+
+func (tt *taskTag) updateSQLTaskTag(ctx context.Context, updateParam entity.UpdateTaskTagParam, selectParam entity.TaskTagParam) error {
+	tt.log.Debug(ctx, fmt.Sprintf("update task tag by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(tt.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = tt.db.Leader().Exec(ctx, "uTaskTag", updateTaskTag+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	tt.log.Debug(ctx, fmt.Sprintf("successfully updated task tag: %v", updateParam))
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package tasktag
+
+const (
+	createTaskTag = `
+	INSERT INTO task_tag (fk_task_id, fk_tag_id, created_by, updated_by)
+	    VALUES (:fk_task_id, :fk_tag_id, :created_by, :updated_by)`
+
+	getTaskTag = `
+		SELECT
+			id,
+			fk_task_id,
+			fk_tag_id,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			task_tag`
+
+	updateTaskTag = `
+	UPDATE
+		task_tag`
+
+	readTaskTagCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			task_tag`
+)
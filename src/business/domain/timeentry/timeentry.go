@@ -0,0 +1,83 @@
+package timeentry
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, timeEntryParam entity.CreateTimeEntryParam) (entity.TimeEntry, error)
+	Get(ctx context.Context, params entity.TimeEntryParam) (entity.TimeEntry, error)
+	GetList(ctx context.Context, params entity.TimeEntryParam) ([]entity.TimeEntry, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTimeEntryParam, selectParam entity.TimeEntryParam) error
+	GetTotal(ctx context.Context, params entity.TimeEntryTotalParam) ([]entity.TimeEntryTotal, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type timeEntry struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	te := &timeEntry{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return te
+}
+
+func (te *timeEntry) Create(ctx context.Context, timeEntryParam entity.CreateTimeEntryParam) (entity.TimeEntry, error) {
+	timeEntry := entity.TimeEntry{}
+
+	tx, err := te.db.Leader().BeginTx(ctx, "txcTimeEntry", sql.TxOptions{})
+	if err != nil {
+		return timeEntry, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, timeEntry, err = te.createSQLTimeEntry(tx, timeEntryParam)
+	if err != nil {
+		return timeEntry, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return timeEntry, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return te.Get(ctx, entity.TimeEntryParam{
+		ID: null.Int64From(timeEntry.ID),
+	})
+}
+
+func (te *timeEntry) Get(ctx context.Context, params entity.TimeEntryParam) (entity.TimeEntry, error) {
+	return te.getSQLTimeEntry(ctx, params)
+}
+
+func (te *timeEntry) GetList(ctx context.Context, params entity.TimeEntryParam) ([]entity.TimeEntry, *entity.Pagination, error) {
+	return te.getSQLTimeEntryList(ctx, params)
+}
+
+func (te *timeEntry) Update(ctx context.Context, updateParam entity.UpdateTimeEntryParam, selectParam entity.TimeEntryParam) error {
+	return te.updateSQLTimeEntry(ctx, updateParam, selectParam)
+}
+
+// GetTotal sum the time spent by the user per group, the running timer is counted until the given now
+func (te *timeEntry) GetTotal(ctx context.Context, params entity.TimeEntryTotalParam) ([]entity.TimeEntryTotal, error) {
+	return te.getSQLTimeEntryTotal(ctx, params)
+}
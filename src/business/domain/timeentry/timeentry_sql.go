@@ -0,0 +1,182 @@
+package timeentry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/sqlutil"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+// Unique index of the running timers, a user can only have one running timer
+const uniqueRunningUser = "idx_time_entry_running_user_id"
+
+func (te *timeEntry) createSQLTimeEntry(tx sql.CommandTx, v entity.CreateTimeEntryParam) (sql.CommandTx, entity.TimeEntry, error) {
+	timeEntry := entity.TimeEntry{}
+
+	res, err := tx.NamedExec("iCreateTimeEntry", createTimeEntry, v)
+	if sqlutil.IsDuplicateEntry(err, uniqueRunningUser) {
+		return tx, timeEntry, errors.NewWithCode(codes.CodeConflict, "timer is already running")
+	} else if err != nil {
+		return tx, timeEntry, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, timeEntry, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, timeEntry, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	timeEntry.ID = lastID
+
+	return tx, timeEntry, nil
+}
+
+func (te *timeEntry) getSQLTimeEntry(ctx context.Context, params entity.TimeEntryParam) (entity.TimeEntry, error) {
+	timeEntry := entity.TimeEntry{}
+
+	qb := query.NewSQLQueryBuilder(te.db, "param", "db", &params.QueryOption)
+	if params.Running {
+		qb.AddPrefixQuery("ended_at IS NULL")
+	}
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return timeEntry, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := te.db.Follower().QueryRow(ctx, "rTimeEntryByID", getTimeEntry+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return timeEntry, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return timeEntry, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&timeEntry); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return timeEntry, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return timeEntry, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	timeEntry.Running = !timeEntry.EndedAt.Valid
+
+	return timeEntry, nil
+}
+
+func (te *timeEntry) getSQLTimeEntryList(ctx context.Context, params entity.TimeEntryParam) ([]entity.TimeEntry, *entity.Pagination, error) {
+	timeEntries := []entity.TimeEntry{}
+
+	qb := query.NewSQLQueryBuilder(te.db, "param", "db", &params.QueryOption)
+	if params.Running {
+		qb.AddPrefixQuery("ended_at IS NULL")
+	}
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return timeEntries, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := te.db.Follower().Query(ctx, "rListTimeEntry", getTimeEntry+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return timeEntries, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TimeEntry{}
+		if err := rows.StructScan(&temp); err != nil {
+			te.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		temp.Running = !temp.EndedAt.Valid
+		timeEntries = append(timeEntries, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(timeEntries)),
+	}
+
+	if len(timeEntries) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := te.db.Follower().Get(ctx, "cTimeEntry", readTimeEntryCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return timeEntries, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return timeEntries, &pg, nil
+}
+
+func (te *timeEntry) updateSQLTimeEntry(ctx context.Context, updateParam entity.UpdateTimeEntryParam, selectParam entity.TimeEntryParam) error {
+	te.log.Debug(ctx, fmt.Sprintf("update time entry by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(te.db, "param", "db", &selectParam.QueryOption)
+
+	var err error
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = te.db.Leader().Exec(ctx, "uTimeEntry", updateTimeEntry+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	te.log.Debug(ctx, fmt.Sprintf("successfully updated time entry: %v", updateParam))
+
+	return nil
+}
+
+func (te *timeEntry) getSQLTimeEntryTotal(ctx context.Context, params entity.TimeEntryTotalParam) ([]entity.TimeEntryTotal, error) {
+	results := []entity.TimeEntryTotal{}
+
+	args := []interface{}{}
+	var groupExpr string
+	switch params.GroupBy {
+	case entity.TimeEntryGroupByTask:
+		groupExpr = "te.fk_task_id"
+	case entity.TimeEntryGroupByCategory:
+		groupExpr = "IFNULL(t.fk_category_id, 0)"
+	case entity.TimeEntryGroupByDay:
+		// The day is taken in the location of the user, e.g. +07:00
+		groupExpr = "DATE(CONVERT_TZ(te.started_at, @@session.time_zone, ?))"
+		args = append(args, params.From.In(params.Location).Format("-07:00"))
+	default:
+		return results, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid group %q", params.GroupBy))
+	}
+
+	args = append(args, params.Now, params.UserID, params.From, params.To)
+
+	filter := ""
+	if params.TaskID.Valid {
+		filter = "AND te.fk_task_id = ?"
+		args = append(args, params.TaskID.Int64)
+	}
+
+	rows, err := te.db.Follower().Query(ctx, "rTimeEntryTotal", fmt.Sprintf(readTimeEntryTotal, groupExpr, filter), args...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return results, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.TimeEntryTotal{}
+		if err := rows.StructScan(&temp); err != nil {
+			te.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		results = append(results, temp)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,54 @@
+package timeentry
+
+const (
+	createTimeEntry = `
+	INSERT INTO time_entry (fk_task_id, fk_user_id, started_at, ended_at, duration_seconds, note, created_by, updated_by)
+	    VALUES (:fk_task_id, :fk_user_id, :started_at, :ended_at, :duration_seconds, :note, :created_by, :updated_by)`
+
+	getTimeEntry = `
+		SELECT
+			id,
+			fk_task_id,
+			fk_user_id,
+			started_at,
+			ended_at,
+			duration_seconds,
+			note,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			time_entry`
+
+	updateTimeEntry = `
+	UPDATE
+		time_entry`
+
+	readTimeEntryCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			time_entry`
+
+	// The group expression is filled by the domain from a fixed list, never from the user input
+	readTimeEntryTotal = `
+		SELECT
+			%s AS group_key,
+			COUNT(*) AS entry_count,
+			SUM(IF(te.ended_at IS NULL, GREATEST(TIMESTAMPDIFF(SECOND, te.started_at, ?), 0), te.duration_seconds)) AS duration_seconds
+		FROM
+			time_entry te
+			INNER JOIN task t ON t.id = te.fk_task_id
+		WHERE
+			te.status = 1
+			AND te.fk_user_id = ?
+			AND te.started_at >= ?
+			AND te.started_at < ?
+			%s
+		GROUP BY
+			group_key
+		ORDER BY
+			group_key`
+)
@@ -13,6 +13,8 @@ const (
 	    username,
 	    password,
 	    display_name,
+	    calendar_token,
+	    leaderboard_opt_out,
 	    status,
 	    created_at,
 	    created_by,
@@ -0,0 +1,46 @@
+package userachievement
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, userAchievementParam entity.CreateUserAchievementParam) (bool, error)
+	GetList(ctx context.Context, params entity.UserAchievementParam) ([]entity.UserAchievement, *entity.Pagination, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type userAchievement struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	ua := &userAchievement{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return ua
+}
+
+// Create unlock the achievement for the user, false is returned when it was already unlocked
+func (ua *userAchievement) Create(ctx context.Context, userAchievementParam entity.CreateUserAchievementParam) (bool, error) {
+	return ua.createSQLUserAchievement(ctx, userAchievementParam)
+}
+
+func (ua *userAchievement) GetList(ctx context.Context, params entity.UserAchievementParam) ([]entity.UserAchievement, *entity.Pagination, error) {
+	return ua.getSQLUserAchievementList(ctx, params)
+}
@@ -0,0 +1,66 @@
+package userachievement
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (ua *userAchievement) getSQLUserAchievementList(ctx context.Context, params entity.UserAchievementParam) ([]entity.UserAchievement, *entity.Pagination, error) {
+	userAchievements := []entity.UserAchievement{}
+
+	qb := query.NewSQLQueryBuilder(ua.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return userAchievements, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := ua.db.Follower().Query(ctx, "rListUserAchievement", getUserAchievement+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return userAchievements, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.UserAchievement{}
+		if err := rows.StructScan(&temp); err != nil {
+			ua.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		userAchievements = append(userAchievements, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(userAchievements)),
+	}
+
+	if len(userAchievements) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := ua.db.Follower().Get(ctx, "cUserAchievement", readUserAchievementCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return userAchievements, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return userAchievements, &pg, nil
+}
+
+func (ua *userAchievement) createSQLUserAchievement(ctx context.Context, v entity.CreateUserAchievementParam) (bool, error) {
+	res, err := ua.db.Leader().NamedExec(ctx, "iCreateUserAchievement", createUserAchievement, v)
+	if err != nil {
+		return false, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	return rowCount > 0, nil
+}
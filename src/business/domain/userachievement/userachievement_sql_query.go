@@ -0,0 +1,29 @@
+package userachievement
+
+const (
+	// The achievement which was already unlocked by the user is skipped, so no row is affected
+	createUserAchievement = `
+	INSERT INTO user_achievement (fk_user_id, fk_achievement_id, unlocked_at, created_by, updated_by)
+	    VALUES (:fk_user_id, :fk_achievement_id, :unlocked_at, :created_by, :updated_by)
+	    ON DUPLICATE KEY UPDATE id = id`
+
+	getUserAchievement = `
+		SELECT
+			id,
+			fk_user_id,
+			fk_achievement_id,
+			unlocked_at,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			user_achievement`
+
+	readUserAchievementCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			user_achievement`
+)
@@ -0,0 +1,91 @@
+package userprogress
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Get(ctx context.Context, params entity.UserProgressParam) (entity.UserProgress, error)
+	Award(ctx context.Context, awardParam entity.CreateXPAwardParam) (entity.UserProgress, bool, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type userProgress struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	up := &userProgress{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return up
+}
+
+func (up *userProgress) Get(ctx context.Context, params entity.UserProgressParam) (entity.UserProgress, error) {
+	return up.getSQLUserProgress(ctx, params)
+}
+
+// Award record the XP of the completed task and add it to the progress of the user in one transaction.
+// The task is only awarded once, false is returned with the current progress when it was already awarded
+func (up *userProgress) Award(ctx context.Context, awardParam entity.CreateXPAwardParam) (entity.UserProgress, bool, error) {
+	progress := entity.UserProgress{}
+
+	tx, err := up.db.Leader().BeginTx(ctx, "txcXPAward", sql.TxOptions{})
+	if err != nil {
+		return progress, false, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, isAwarded, err := up.createSQLXPAward(tx, awardParam)
+	if err != nil {
+		return progress, false, err
+	}
+
+	if !isAwarded {
+		progress, err = up.Get(ctx, entity.UserProgressParam{UserID: null.Int64From(awardParam.UserID)})
+		if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+			progress = entity.UserProgress{UserID: awardParam.UserID}
+			progress.ProcessLevel()
+			return progress, false, nil
+		}
+		return progress, false, err
+	}
+
+	tx, progress, err = up.lockSQLUserProgress(tx, awardParam)
+	if err != nil {
+		return progress, false, err
+	}
+
+	progress.AddCompletion(awardParam.XP, awardParam.Day)
+
+	tx, err = up.updateSQLUserProgressAward(ctx, tx, progress, awardParam)
+	if err != nil {
+		return progress, false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return progress, false, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	progress.ProcessLevel()
+
+	return progress, true, nil
+}
@@ -0,0 +1,88 @@
+package userprogress
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (up *userProgress) getSQLUserProgress(ctx context.Context, params entity.UserProgressParam) (entity.UserProgress, error) {
+	progress := entity.UserProgress{}
+
+	qb := query.NewSQLQueryBuilder(up.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return progress, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := up.db.Follower().QueryRow(ctx, "rUserProgressByID", getUserProgress+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return progress, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return progress, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&progress); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return progress, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return progress, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	progress.ProcessLevel()
+
+	return progress, nil
+}
+
+func (up *userProgress) createSQLXPAward(tx sql.CommandTx, v entity.CreateXPAwardParam) (sql.CommandTx, bool, error) {
+	res, err := tx.NamedExec("iCreateXPAward", createXPAward, v)
+	if err != nil {
+		return tx, false, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil {
+		return tx, false, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	return tx, rowCount > 0, nil
+}
+
+// lockSQLUserProgress create the progress of the user when it does not exist yet and lock it until the transaction ends,
+// so the concurrent awards of the same user are added one after another
+func (up *userProgress) lockSQLUserProgress(tx sql.CommandTx, v entity.CreateXPAwardParam) (sql.CommandTx, entity.UserProgress, error) {
+	progress := entity.UserProgress{}
+
+	if _, err := tx.Exec("iCreateUserProgress", createUserProgress, v.UserID, v.CreatedBy, v.UpdatedBy); err != nil {
+		return tx, progress, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	if err := tx.Get("rUserProgressForUpdate", getUserProgressForUpdate, &progress, v.UserID); err != nil {
+		return tx, progress, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	return tx, progress, nil
+}
+
+func (up *userProgress) updateSQLUserProgressAward(ctx context.Context, tx sql.CommandTx, progress entity.UserProgress, v entity.CreateXPAwardParam) (sql.CommandTx, error) {
+	up.log.Debug(ctx, fmt.Sprintf("award user progress: %v", progress))
+
+	_, err := tx.Exec("uUserProgressAward", updateUserProgressAward,
+		progress.XP,
+		progress.CompletedTasks,
+		progress.CurrentStreak,
+		progress.LongestStreak,
+		progress.LastCompletedOn,
+		v.UpdatedBy,
+		progress.ID,
+	)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,49 @@
+package userprogress
+
+const (
+	// The award of a task which was already awarded is skipped, so no row is affected
+	createXPAward = `
+	INSERT INTO xp_award (fk_user_id, fk_task_id, xp, on_time, created_by, updated_by)
+	    VALUES (:fk_user_id, :fk_task_id, :xp, :on_time, :created_by, :updated_by)
+	    ON DUPLICATE KEY UPDATE id = id`
+
+	createUserProgress = `
+	INSERT INTO user_progress (fk_user_id, created_by, updated_by)
+	    VALUES (?, ?, ?)
+	    ON DUPLICATE KEY UPDATE id = id`
+
+	getUserProgress = `
+		SELECT
+			id,
+			fk_user_id,
+			xp,
+			completed_tasks,
+			current_streak,
+			longest_streak,
+			last_completed_on,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			user_progress`
+
+	getUserProgressForUpdate = getUserProgress + `
+		WHERE
+			fk_user_id = ?
+		FOR UPDATE`
+
+	updateUserProgressAward = `
+	UPDATE
+		user_progress
+	SET
+		xp = ?,
+		completed_tasks = ?,
+		current_streak = ?,
+		longest_streak = ?,
+		last_completed_on = ?,
+		updated_by = ?
+	WHERE
+		id = ?`
+)
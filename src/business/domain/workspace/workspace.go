@@ -0,0 +1,138 @@
+package workspace
+
+import (
+	"context"
+
+	workspaceMemberDom "github.com/adiatma85/gg-project/src/business/domain/workspacemember"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, workspaceParam entity.CreateWorkspaceParam) (entity.Workspace, error)
+	Get(ctx context.Context, params entity.WorkspaceParam) (entity.Workspace, error)
+	GetList(ctx context.Context, params entity.WorkspaceParam) ([]entity.Workspace, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateWorkspaceParam, selectParam entity.WorkspaceParam) error
+	TransferOwnership(ctx context.Context, workspace entity.Workspace, newOwnerID int64, updatedBy null.String) error
+}
+
+type InitParam struct {
+	Log             log.Interface
+	Db              sql.Interface
+	Json            parser.JSONInterface
+	WorkspaceMember workspaceMemberDom.Interface
+}
+
+type workspace struct {
+	log             log.Interface
+	db              sql.Interface
+	json            parser.JSONInterface
+	workspaceMember workspaceMemberDom.Interface
+}
+
+func Init(param InitParam) Interface {
+	w := &workspace{
+		log:             param.Log,
+		db:              param.Db,
+		json:            param.Json,
+		workspaceMember: param.WorkspaceMember,
+	}
+
+	return w
+}
+
+// Create the workspace and add its owner as the first member in one transaction
+func (w *workspace) Create(ctx context.Context, workspaceParam entity.CreateWorkspaceParam) (entity.Workspace, error) {
+	workspace := entity.Workspace{}
+
+	tx, err := w.db.Leader().BeginTx(ctx, "txcWorkspace", sql.TxOptions{})
+	if err != nil {
+		return workspace, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, workspace, err = w.createSQLWorkspace(tx, workspaceParam)
+	if err != nil {
+		return workspace, err
+	}
+
+	tx, err = w.workspaceMember.CreateTx(tx, entity.CreateWorkspaceMemberParam{
+		WorkspaceID: workspace.ID,
+		UserID:      workspaceParam.OwnerID,
+		Role:        entity.WorkspaceRoleOwner,
+		CreatedBy:   workspaceParam.CreatedBy,
+		UpdatedBy:   workspaceParam.UpdatedBy,
+	})
+	if err != nil {
+		return workspace, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return workspace, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return w.Get(ctx, entity.WorkspaceParam{
+		ID: null.Int64From(workspace.ID),
+	})
+}
+
+func (w *workspace) Get(ctx context.Context, params entity.WorkspaceParam) (entity.Workspace, error) {
+	return w.getSQLWorkspace(ctx, params)
+}
+
+func (w *workspace) GetList(ctx context.Context, params entity.WorkspaceParam) ([]entity.Workspace, *entity.Pagination, error) {
+	return w.getSQLWorkspaceList(ctx, params)
+}
+
+func (w *workspace) Update(ctx context.Context, updateParam entity.UpdateWorkspaceParam, selectParam entity.WorkspaceParam) error {
+	return w.updateSQLWorkspace(ctx, updateParam, selectParam)
+}
+
+// TransferOwnership make the member the owner of the workspace and the old owner an admin in one transaction
+func (w *workspace) TransferOwnership(ctx context.Context, workspace entity.Workspace, newOwnerID int64, updatedBy null.String) error {
+	tx, err := w.db.Leader().BeginTx(ctx, "txuWorkspaceOwner", sql.TxOptions{})
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, err = w.updateSQLWorkspaceTx(ctx, tx, entity.UpdateWorkspaceParam{
+		OwnerID:   null.Int64From(newOwnerID),
+		UpdatedBy: updatedBy,
+	}, entity.WorkspaceParam{ID: null.Int64From(workspace.ID)})
+	if err != nil {
+		return err
+	}
+
+	roles := map[int64]string{
+		workspace.OwnerID: entity.WorkspaceRoleAdmin,
+		newOwnerID:        entity.WorkspaceRoleOwner,
+	}
+	for userID, role := range roles {
+		tx, err = w.workspaceMember.UpdateTx(ctx, tx, entity.UpdateWorkspaceMemberParam{
+			Role:      role,
+			UpdatedBy: updatedBy,
+		}, entity.WorkspaceMemberParam{
+			WorkspaceID: null.Int64From(workspace.ID),
+			UserID:      null.Int64From(userID),
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (w *workspace) createSQLWorkspace(tx sql.CommandTx, v entity.CreateWorkspaceParam) (sql.CommandTx, entity.Workspace, error) {
+	workspace := entity.Workspace{}
+
+	res, err := tx.NamedExec("iCreateWorkspace", createWorkspace, v)
+	if err != nil {
+		return tx, workspace, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, workspace, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, workspace, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	workspace.ID = lastID
+
+	return tx, workspace, nil
+}
+
+func (w *workspace) getSQLWorkspace(ctx context.Context, params entity.WorkspaceParam) (entity.Workspace, error) {
+	workspace := entity.Workspace{}
+
+	qb := query.NewSQLQueryBuilder(w.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return workspace, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := w.db.Follower().QueryRow(ctx, "rWorkspaceByID", getWorkspace+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return workspace, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return workspace, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&workspace); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return workspace, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return workspace, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return workspace, nil
+}
+
+func (w *workspace) getSQLWorkspaceList(ctx context.Context, params entity.WorkspaceParam) ([]entity.Workspace, *entity.Pagination, error) {
+	workspaces := []entity.Workspace{}
+
+	qb := query.NewSQLQueryBuilder(w.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return workspaces, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := w.db.Follower().Query(ctx, "rListWorkspace", getWorkspace+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return workspaces, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.Workspace{}
+		if err := rows.StructScan(&temp); err != nil {
+			w.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		workspaces = append(workspaces, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(workspaces)),
+	}
+
+	if len(workspaces) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := w.db.Follower().Get(ctx, "cWorkspace", readWorkspaceCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return workspaces, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return workspaces, &pg, nil
+}
+
+func (w *workspace) updateSQLWorkspace(ctx context.Context, updateParam entity.UpdateWorkspaceParam, selectParam entity.WorkspaceParam) error {
+	w.log.Debug(ctx, fmt.Sprintf("update workspace by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(w.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = w.db.Leader().Exec(ctx, "uWorkspace", updateWorkspace+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	w.log.Debug(ctx, fmt.Sprintf("successfully updated workspace: %v", updateParam))
+
+	return nil
+}
+
+func (w *workspace) updateSQLWorkspaceTx(ctx context.Context, tx sql.CommandTx, updateParam entity.UpdateWorkspaceParam, selectParam entity.WorkspaceParam) (sql.CommandTx, error) {
+	w.log.Debug(ctx, fmt.Sprintf("update workspace by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(w.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = tx.Exec("uWorkspaceTx", updateWorkspace+queryUpdate, args...)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	w.log.Debug(ctx, fmt.Sprintf("successfully updated workspace: %v", updateParam))
+
+	return tx, nil
+}
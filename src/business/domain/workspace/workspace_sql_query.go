@@ -0,0 +1,31 @@
+package workspace
+
+const (
+	createWorkspace = `
+	INSERT INTO workspace (fk_owner_id, name, description, created_by, updated_by)
+	    VALUES (:fk_owner_id, :name, :description, :created_by, :updated_by)`
+
+	getWorkspace = `
+		SELECT
+			id,
+			fk_owner_id,
+			name,
+			description,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			workspace`
+
+	updateWorkspace = `
+	UPDATE
+		workspace`
+
+	readWorkspaceCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			workspace`
+)
@@ -0,0 +1,77 @@
+package workspaceinvitation
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, invitationParam entity.CreateWorkspaceInvitationParam) (entity.WorkspaceInvitation, error)
+	Get(ctx context.Context, params entity.WorkspaceInvitationParam) (entity.WorkspaceInvitation, error)
+	GetList(ctx context.Context, params entity.WorkspaceInvitationParam) ([]entity.WorkspaceInvitation, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateWorkspaceInvitationParam, selectParam entity.WorkspaceInvitationParam) error
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type workspaceInvitation struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	wi := &workspaceInvitation{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return wi
+}
+
+func (wi *workspaceInvitation) Create(ctx context.Context, invitationParam entity.CreateWorkspaceInvitationParam) (entity.WorkspaceInvitation, error) {
+	invitation := entity.WorkspaceInvitation{}
+
+	tx, err := wi.db.Leader().BeginTx(ctx, "txcWorkspaceInvitation", sql.TxOptions{})
+	if err != nil {
+		return invitation, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, invitation, err = wi.createSQLWorkspaceInvitation(tx, invitationParam)
+	if err != nil {
+		return invitation, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return invitation, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return wi.Get(ctx, entity.WorkspaceInvitationParam{
+		ID: null.Int64From(invitation.ID),
+	})
+}
+
+func (wi *workspaceInvitation) Get(ctx context.Context, params entity.WorkspaceInvitationParam) (entity.WorkspaceInvitation, error) {
+	return wi.getSQLWorkspaceInvitation(ctx, params)
+}
+
+func (wi *workspaceInvitation) GetList(ctx context.Context, params entity.WorkspaceInvitationParam) ([]entity.WorkspaceInvitation, *entity.Pagination, error) {
+	return wi.getSQLWorkspaceInvitationList(ctx, params)
+}
+
+func (wi *workspaceInvitation) Update(ctx context.Context, updateParam entity.UpdateWorkspaceInvitationParam, selectParam entity.WorkspaceInvitationParam) error {
+	return wi.updateSQLWorkspaceInvitation(ctx, updateParam, selectParam)
+}
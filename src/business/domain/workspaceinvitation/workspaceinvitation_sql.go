@@ -0,0 +1,121 @@
+package workspaceinvitation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (wi *workspaceInvitation) createSQLWorkspaceInvitation(tx sql.CommandTx, v entity.CreateWorkspaceInvitationParam) (sql.CommandTx, entity.WorkspaceInvitation, error) {
+	invitation := entity.WorkspaceInvitation{}
+
+	res, err := tx.NamedExec("iCreateWorkspaceInvitation", createWorkspaceInvitation, v)
+	if err != nil {
+		return tx, invitation, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	rowCount, err := res.RowsAffected()
+	if err != nil || rowCount < 1 {
+		return tx, invitation, errors.NewWithCode(codes.CodeSQLNoRowsAffected, "no rows affected")
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return tx, invitation, errors.NewWithCode(codes.CodeSQLNoRowsAffected, err.Error())
+	}
+
+	invitation.ID = lastID
+
+	return tx, invitation, nil
+}
+
+func (wi *workspaceInvitation) getSQLWorkspaceInvitation(ctx context.Context, params entity.WorkspaceInvitationParam) (entity.WorkspaceInvitation, error) {
+	invitation := entity.WorkspaceInvitation{}
+
+	qb := query.NewSQLQueryBuilder(wi.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return invitation, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := wi.db.Follower().QueryRow(ctx, "rWorkspaceInvitationByID", getWorkspaceInvitation+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return invitation, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return invitation, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&invitation); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return invitation, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return invitation, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return invitation, nil
+}
+
+func (wi *workspaceInvitation) getSQLWorkspaceInvitationList(ctx context.Context, params entity.WorkspaceInvitationParam) ([]entity.WorkspaceInvitation, *entity.Pagination, error) {
+	invitations := []entity.WorkspaceInvitation{}
+
+	qb := query.NewSQLQueryBuilder(wi.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return invitations, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := wi.db.Follower().Query(ctx, "rListWorkspaceInvitation", getWorkspaceInvitation+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return invitations, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.WorkspaceInvitation{}
+		if err := rows.StructScan(&temp); err != nil {
+			wi.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		invitations = append(invitations, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(invitations)),
+	}
+
+	if len(invitations) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := wi.db.Follower().Get(ctx, "cWorkspaceInvitation", readWorkspaceInvitationCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return invitations, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return invitations, &pg, nil
+}
+
+func (wi *workspaceInvitation) updateSQLWorkspaceInvitation(ctx context.Context, updateParam entity.UpdateWorkspaceInvitationParam, selectParam entity.WorkspaceInvitationParam) error {
+	wi.log.Debug(ctx, fmt.Sprintf("update workspace invitation by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(wi.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = wi.db.Leader().Exec(ctx, "uWorkspaceInvitation", updateWorkspaceInvitation+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	wi.log.Debug(ctx, fmt.Sprintf("successfully updated workspace invitation: %v", updateParam))
+
+	return nil
+}
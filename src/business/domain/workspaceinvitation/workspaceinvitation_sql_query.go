@@ -0,0 +1,34 @@
+package workspaceinvitation
+
+const (
+	createWorkspaceInvitation = `
+	INSERT INTO workspace_invitation (fk_workspace_id, fk_inviter_id, email, workspace_role, created_by, updated_by)
+	    VALUES (:fk_workspace_id, :fk_inviter_id, :email, :workspace_role, :created_by, :updated_by)`
+
+	getWorkspaceInvitation = `
+		SELECT
+			id,
+			fk_workspace_id,
+			fk_inviter_id,
+			email,
+			workspace_role,
+			invitation_status,
+			responded_at,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			workspace_invitation`
+
+	updateWorkspaceInvitation = `
+	UPDATE
+		workspace_invitation`
+
+	readWorkspaceInvitationCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_invitation`
+)
@@ -0,0 +1,91 @@
+package workspacemember
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/parser"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+type Interface interface {
+	Create(ctx context.Context, memberParam entity.CreateWorkspaceMemberParam) (entity.WorkspaceMember, error)
+	Get(ctx context.Context, params entity.WorkspaceMemberParam) (entity.WorkspaceMember, error)
+	GetList(ctx context.Context, params entity.WorkspaceMemberParam) ([]entity.WorkspaceMember, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateWorkspaceMemberParam, selectParam entity.WorkspaceMemberParam) error
+	CreateTx(tx sql.CommandTx, memberParam entity.CreateWorkspaceMemberParam) (sql.CommandTx, error)
+	UpdateTx(ctx context.Context, tx sql.CommandTx, updateParam entity.UpdateWorkspaceMemberParam, selectParam entity.WorkspaceMemberParam) (sql.CommandTx, error)
+}
+
+type InitParam struct {
+	Log  log.Interface
+	Db   sql.Interface
+	Json parser.JSONInterface
+}
+
+type workspaceMember struct {
+	log  log.Interface
+	db   sql.Interface
+	json parser.JSONInterface
+}
+
+func Init(param InitParam) Interface {
+	wm := &workspaceMember{
+		log:  param.Log,
+		db:   param.Db,
+		json: param.Json,
+	}
+
+	return wm
+}
+
+// Create add the user to the workspace, the user who left the workspace before is restored instead
+func (wm *workspaceMember) Create(ctx context.Context, memberParam entity.CreateWorkspaceMemberParam) (entity.WorkspaceMember, error) {
+	member := entity.WorkspaceMember{}
+
+	tx, err := wm.db.Leader().BeginTx(ctx, "txcWorkspaceMember", sql.TxOptions{})
+	if err != nil {
+		return member, errors.NewWithCode(codes.CodeSQLTxBegin, err.Error())
+	}
+	defer tx.Rollback()
+
+	tx, err = wm.createSQLWorkspaceMember(tx, memberParam)
+	if err != nil {
+		return member, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return member, errors.NewWithCode(codes.CodeSQLTxCommit, err.Error())
+	}
+
+	return wm.Get(ctx, entity.WorkspaceMemberParam{
+		WorkspaceID: null.Int64From(memberParam.WorkspaceID),
+		UserID:      null.Int64From(memberParam.UserID),
+	})
+}
+
+func (wm *workspaceMember) Get(ctx context.Context, params entity.WorkspaceMemberParam) (entity.WorkspaceMember, error) {
+	return wm.getSQLWorkspaceMember(ctx, params)
+}
+
+func (wm *workspaceMember) GetList(ctx context.Context, params entity.WorkspaceMemberParam) ([]entity.WorkspaceMember, *entity.Pagination, error) {
+	return wm.getSQLWorkspaceMemberList(ctx, params)
+}
+
+func (wm *workspaceMember) Update(ctx context.Context, updateParam entity.UpdateWorkspaceMemberParam, selectParam entity.WorkspaceMemberParam) error {
+	return wm.updateSQLWorkspaceMember(ctx, updateParam, selectParam)
+}
+
+// CreateTx add the user to the workspace inside the transaction owned by the caller
+func (wm *workspaceMember) CreateTx(tx sql.CommandTx, memberParam entity.CreateWorkspaceMemberParam) (sql.CommandTx, error) {
+	return wm.createSQLWorkspaceMember(tx, memberParam)
+}
+
+// UpdateTx update the members inside the transaction owned by the caller
+func (wm *workspaceMember) UpdateTx(ctx context.Context, tx sql.CommandTx, updateParam entity.UpdateWorkspaceMemberParam, selectParam entity.WorkspaceMemberParam) (sql.CommandTx, error) {
+	return wm.updateSQLWorkspaceMemberTx(ctx, tx, updateParam, selectParam)
+}
@@ -0,0 +1,127 @@
+package workspacemember
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+func (wm *workspaceMember) createSQLWorkspaceMember(tx sql.CommandTx, v entity.CreateWorkspaceMemberParam) (sql.CommandTx, error) {
+	// No row is affected when the user is already an active member, the member is read afterward either way
+	if _, err := tx.NamedExec("iCreateWorkspaceMember", createWorkspaceMember, v); err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	return tx, nil
+}
+
+func (wm *workspaceMember) getSQLWorkspaceMember(ctx context.Context, params entity.WorkspaceMemberParam) (entity.WorkspaceMember, error) {
+	member := entity.WorkspaceMember{}
+
+	qb := query.NewSQLQueryBuilder(wm.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, _, _, err := qb.Build(&params)
+	if err != nil {
+		return member, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	row, err := wm.db.Follower().QueryRow(ctx, "rWorkspaceMemberByID", getWorkspaceMember+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return member, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return member, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	if err := row.StructScan(&member); err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return member, errors.NewWithCode(codes.CodeSQLRowScan, err.Error())
+	} else if errors.Is(err, sql.ErrNotFound) {
+		return member, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, err.Error())
+	}
+
+	return member, nil
+}
+
+func (wm *workspaceMember) getSQLWorkspaceMemberList(ctx context.Context, params entity.WorkspaceMemberParam) ([]entity.WorkspaceMember, *entity.Pagination, error) {
+	members := []entity.WorkspaceMember{}
+
+	qb := query.NewSQLQueryBuilder(wm.db, "param", "db", &params.QueryOption)
+	queryExt, queryArgs, countExt, countArgs, err := qb.Build(&params)
+	if err != nil {
+		return members, nil, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	rows, err := wm.db.Follower().Query(ctx, "rListWorkspaceMember", getWorkspaceMember+queryExt, queryArgs...)
+	if err != nil && !errors.Is(err, sql.ErrNotFound) {
+		return members, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		temp := entity.WorkspaceMember{}
+		if err := rows.StructScan(&temp); err != nil {
+			wm.log.Error(ctx, errors.NewWithCode(codes.CodeSQLRowScan, err.Error()))
+			continue
+		}
+		members = append(members, temp)
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     params.Page,
+		CurrentElements: int64(len(members)),
+	}
+
+	if len(members) > 0 && !params.QueryOption.DisableLimit && params.IncludePagination {
+		if err := wm.db.Follower().Get(ctx, "cWorkspaceMember", readWorkspaceMemberCount+countExt, &pg.TotalElements, countArgs...); err != nil {
+			return members, nil, errors.NewWithCode(codes.CodeSQLRead, err.Error())
+		}
+	}
+
+	pg.ProcessPagination(params.Limit)
+
+	return members, &pg, nil
+}
+
+func (wm *workspaceMember) updateSQLWorkspaceMember(ctx context.Context, updateParam entity.UpdateWorkspaceMemberParam, selectParam entity.WorkspaceMemberParam) error {
+	wm.log.Debug(ctx, fmt.Sprintf("update workspace member by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(wm.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = wm.db.Leader().Exec(ctx, "uWorkspaceMember", updateWorkspaceMember+queryUpdate, args...)
+	if err != nil {
+		return errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	wm.log.Debug(ctx, fmt.Sprintf("successfully updated workspace member: %v", updateParam))
+
+	return nil
+}
+
+func (wm *workspaceMember) updateSQLWorkspaceMemberTx(ctx context.Context, tx sql.CommandTx, updateParam entity.UpdateWorkspaceMemberParam, selectParam entity.WorkspaceMemberParam) (sql.CommandTx, error) {
+	wm.log.Debug(ctx, fmt.Sprintf("update workspace member by: %v", selectParam))
+
+	qb := query.NewSQLQueryBuilder(wm.db, "param", "db", &selectParam.QueryOption)
+
+	queryUpdate, args, err := qb.BuildUpdate(&updateParam, &selectParam)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLBuilder, err.Error())
+	}
+
+	_, err = tx.Exec("uWorkspaceMemberTx", updateWorkspaceMember+queryUpdate, args...)
+	if err != nil {
+		return tx, errors.NewWithCode(codes.CodeSQLTxExec, err.Error())
+	}
+
+	wm.log.Debug(ctx, fmt.Sprintf("successfully updated workspace member: %v", updateParam))
+
+	return tx, nil
+}
@@ -0,0 +1,38 @@
+package workspacemember
+
+const (
+	// The unique member index restore the member who left with the new role, an active member is left as is
+	createWorkspaceMember = `
+	INSERT INTO workspace_member (fk_workspace_id, fk_user_id, workspace_role, created_by, updated_by)
+	    VALUES (:fk_workspace_id, :fk_user_id, :workspace_role, :created_by, :updated_by)
+	    ON DUPLICATE KEY UPDATE
+	        workspace_role = IF(status = 1, workspace_role, VALUES(workspace_role)),
+	        updated_by = IF(status = 1, updated_by, VALUES(updated_by)),
+	        deleted_at = IF(status = 1, deleted_at, NULL),
+	        deleted_by = IF(status = 1, deleted_by, NULL),
+	        status = 1`
+
+	getWorkspaceMember = `
+		SELECT
+			id,
+			fk_workspace_id,
+			fk_user_id,
+			workspace_role,
+			status,
+			created_at,
+			created_by,
+			updated_at,
+			updated_by
+		FROM
+			workspace_member`
+
+	updateWorkspaceMember = `
+	UPDATE
+		workspace_member`
+
+	readWorkspaceMemberCount = `
+		SELECT
+			COUNT(*)
+		FROM
+			workspace_member`
+)
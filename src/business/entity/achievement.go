@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+const (
+	// Achievement rules, the threshold of the achievement is compared with the metric of its rule
+	AchievementRuleTasksDone      = "tasks_done"      // Number of the done tasks
+	AchievementRuleStreakDays     = "streak_days"     // Current completion streak in days
+	AchievementRuleLevel          = "level"           // Level of the user
+	AchievementRuleXP             = "xp"              // Total XP of the user
+	AchievementRuleOverdueCleared = "overdue_cleared" // An overdue task is done and no overdue task is left, the threshold is not used
+	AchievementRuleFocusSessions  = "focus_sessions"  // Number of the completed focus sessions
+	AchievementRuleFocusMinutes   = "focus_minutes"   // Total focused minutes
+
+	// Events which the achievements are evaluated on
+	AchievementEventTaskDone       = "task_done"
+	AchievementEventFocusCompleted = "focus_completed"
+)
+
+// AchievementRuleEvents map the rules to the event which can change their metric
+var AchievementRuleEvents = map[string]string{
+	AchievementRuleTasksDone:      AchievementEventTaskDone,
+	AchievementRuleStreakDays:     AchievementEventTaskDone,
+	AchievementRuleLevel:          AchievementEventTaskDone,
+	AchievementRuleXP:             AchievementEventTaskDone,
+	AchievementRuleOverdueCleared: AchievementEventTaskDone,
+	AchievementRuleFocusSessions:  AchievementEventFocusCompleted,
+	AchievementRuleFocusMinutes:   AchievementEventFocusCompleted,
+}
+
+type Achievement struct {
+	ID          int64       `db:"id" json:"id"`
+	Code        string      `db:"code" json:"code"`
+	Name        string      `db:"name" json:"name"`
+	Description string      `db:"description" json:"description"`
+	Icon        string      `db:"icon" json:"icon"`
+	Rule        string      `db:"rule" json:"rule"` //Enum(tasks_done, streak_days, level, xp, overdue_cleared, focus_sessions, focus_minutes)
+	Threshold   int64       `db:"threshold" json:"threshold"`
+	Unlocked    bool        `db:"-" json:"unlocked"`
+	UnlockedAt  null.Time   `db:"-" json:"unlockedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	Status      int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt   null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy   null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt   null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt   null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type AchievementParam struct {
+	ID       null.Int64  `param:"id" uri:"achievement_id" db:"id"`
+	IDs      []int64     `param:"ids" db:"id"`
+	Code     null.String `param:"code" db:"code"`
+	Rules    []string    `param:"rules" db:"rule"`
+	Unlocked string      `db:"-" form:"unlocked"` // Only the unlocked (true) or locked (false) achievements of the user
+	Status   null.Int64  `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateAchievementParam struct {
+	Code        string      `db:"code" json:"code"` // Unique key of the achievement, e.g. streak_30
+	Name        string      `db:"name" json:"name"`
+	Description string      `db:"description" json:"description"`
+	Icon        string      `db:"icon" json:"icon"`
+	Rule        string      `db:"rule" json:"rule"` //Enum(tasks_done, streak_days, level, xp, overdue_cleared, focus_sessions, focus_minutes)
+	Threshold   int64       `db:"threshold" json:"threshold"`
+	CreatedBy   null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy   null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+type UpdateAchievementParam struct {
+	Name        string      `param:"name" db:"name" json:"name"`
+	Description string      `param:"description" db:"description" json:"description"`
+	Icon        string      `param:"icon" db:"icon" json:"icon"`
+	Rule        string      `param:"rule" db:"rule" json:"rule"` //Enum(tasks_done, streak_days, level, xp, overdue_cleared, focus_sessions, focus_minutes)
+	Threshold   int64       `param:"threshold" db:"threshold" json:"threshold"`
+	Status      null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt   null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt   null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+type UserAchievement struct {
+	ID            int64       `db:"id" json:"id"`
+	UserID        int64       `db:"fk_user_id" json:"userId"`
+	AchievementID int64       `db:"fk_achievement_id" json:"achievementId"`
+	UnlockedAt    null.Time   `db:"unlocked_at" json:"unlockedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	Status        int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt     null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy     null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt     null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy     null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+}
+
+type UserAchievementParam struct {
+	ID            null.Int64 `param:"id" db:"id"`
+	UserID        null.Int64 `param:"fk_user_id" db:"fk_user_id"`
+	AchievementID null.Int64 `param:"fk_achievement_id" db:"fk_achievement_id"`
+	Status        null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateUserAchievementParam struct {
+	UserID        int64       `db:"fk_user_id"`
+	AchievementID int64       `db:"fk_achievement_id"`
+	UnlockedAt    null.Time   `db:"unlocked_at"`
+	CreatedBy     null.String `db:"created_by"`
+	UpdatedBy     null.String `db:"updated_by"`
+}
+
+// AchievementEvent is what happened to the user, the achievements of its rules are evaluated with it
+type AchievementEvent struct {
+	Type     string
+	UserID   int64
+	Task     *Task         // The done task of the task_done event
+	Progress *UserProgress // Progress after the event, it is read again when it is empty
+}
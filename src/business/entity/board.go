@@ -0,0 +1,143 @@
+package entity
+
+import (
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+const (
+	// Types of the board column, the status column holds the tasks of its status and moving a task into it changes the
+	// status of the task, the custom column is a lane which leaves the status as is
+	BoardColumnTypeStatus = "status"
+	BoardColumnTypeCustom = "custom"
+)
+
+// Board is a kanban view of the personal tasks of its owner or of the tasks of its workspace
+type Board struct {
+	ID          int64         `db:"id" json:"id"`
+	UserID      int64         `db:"fk_user_id" json:"userId"`                                 // Owner of the board
+	WorkspaceID null.Int64    `db:"fk_workspace_id" json:"workspaceId" swaggertype:"integer"` // Empty on the personal board
+	Name        string        `db:"name" json:"name"`
+	Description null.String   `db:"description" json:"description" swaggertype:"string"`
+	Columns     []BoardColumn `db:"-" json:"columns,omitempty"`
+	Status      null.Int64    `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt   null.Time     `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy   null.String   `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt   null.Time     `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String   `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt   null.Time     `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String   `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type BoardParam struct {
+	ID                 null.Int64 `param:"id" uri:"board_id" db:"id"`
+	UserID             null.Int64 `param:"fk_user_id" db:"fk_user_id"`
+	WorkspaceID        null.Int64 `param:"fk_workspace_id" db:"fk_workspace_id" form:"workspaceId"`
+	AccessUserID       null.Int64 `db:"-"` // Only the boards owned by the user, or shared in AccessWorkspaceIDs
+	AccessWorkspaceIDs []int64    `db:"-"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateBoardParam struct {
+	UserID      int64                    `db:"fk_user_id" json:"-"`
+	WorkspaceID null.Int64               `db:"fk_workspace_id" json:"workspaceId" swaggertype:"integer"`
+	Name        string                   `db:"name" json:"name"`
+	Description null.String              `db:"description" json:"description" swaggertype:"string"`
+	Columns     []CreateBoardColumnParam `db:"-" json:"columns"` // Default is a status column for todo, ongoing and done
+	CreatedBy   null.String              `db:"created_by" json:"-" swaggertype:"string"`
+	UpdatedBy   null.String              `db:"updated_by" json:"-" swaggertype:"string"`
+}
+
+type UpdateBoardParam struct {
+	Name        string      `param:"name" db:"name" json:"name"`
+	Description null.String `param:"description" db:"description" json:"description" swaggertype:"string"`
+	Status      null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt   null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt   null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+type BoardColumn struct {
+	ID          int64       `db:"id" json:"id"`
+	BoardID     int64       `db:"fk_board_id" json:"boardId"`
+	Name        string      `db:"name" json:"name"`
+	ColumnType  string      `db:"column_type" json:"columnType"`                      //Enum(status, custom)
+	TaskStatus  null.String `db:"task_status" json:"taskStatus" swaggertype:"string"` //Enum(todo, ongoing, done), empty on the custom column
+	ColumnOrder int64       `db:"column_order" json:"columnOrder"`                    // Order of the column on the board, ascending
+	Tasks       []Task      `db:"-" json:"tasks"`                                     // Tasks of the column in their position on the board
+	Status      null.Int64  `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt   null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy   null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt   null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt   null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type BoardColumnParam struct {
+	ID          null.Int64 `param:"id" uri:"column_id" db:"id"`
+	BoardID     null.Int64 `param:"fk_board_id" uri:"board_id" db:"fk_board_id"`
+	ColumnOrder null.Int64 `param:"column_order" db:"column_order"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateBoardColumnParam struct {
+	BoardID     int64       `db:"fk_board_id" json:"-"`
+	Name        string      `db:"name" json:"name"`
+	ColumnType  string      `db:"column_type" json:"columnType"`                         //Enum(status, custom), default is status when taskStatus is given
+	TaskStatus  null.String `db:"task_status" json:"taskStatus" swaggertype:"string"`    //Enum(todo, ongoing, done), required by the status column
+	ColumnOrder null.Int64  `db:"column_order" json:"columnOrder" swaggertype:"integer"` // Default is after the last column
+	CreatedBy   null.String `db:"created_by" json:"-" swaggertype:"string"`
+	UpdatedBy   null.String `db:"updated_by" json:"-" swaggertype:"string"`
+}
+
+type UpdateBoardColumnParam struct {
+	Name        string      `param:"name" db:"name" json:"name"`
+	ColumnOrder null.Int64  `param:"column_order" db:"column_order" json:"columnOrder" swaggertype:"integer"`
+	Status      null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt   null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt   null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+// BoardTask is the place of the task which was moved on the board, the task which was never moved is placed in the
+// column of its status by the rank key of the task
+type BoardTask struct {
+	ID        int64       `db:"id" json:"id"`
+	BoardID   int64       `db:"fk_board_id" json:"boardId"`
+	ColumnID  int64       `db:"fk_board_column_id" json:"columnId"`
+	TaskID    int64       `db:"fk_task_id" json:"taskId"`
+	Position  string      `db:"position" json:"position"` // Rank key of the task in the column, compared byte by byte
+	Status    null.Int64  `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+}
+
+type BoardTaskParam struct {
+	BoardID  null.Int64 `param:"fk_board_id" uri:"board_id" db:"fk_board_id"`
+	ColumnID null.Int64 `param:"fk_board_column_id" db:"fk_board_column_id"`
+	TaskID   null.Int64 `param:"fk_task_id" uri:"task_id" db:"fk_task_id"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateBoardTaskParam struct {
+	BoardID   int64       `db:"fk_board_id"`
+	ColumnID  int64       `db:"fk_board_column_id"`
+	TaskID    int64       `db:"fk_task_id"`
+	Position  string      `db:"position"`
+	CreatedBy null.String `db:"created_by"`
+	UpdatedBy null.String `db:"updated_by"`
+}
+
+// MoveBoardTaskParam is the column and the zero based position of the task in the column after it is moved
+type MoveBoardTaskParam struct {
+	ColumnID int64 `json:"columnId"`
+	Position int64 `json:"position"` // Position greater than the number of tasks in the column is the last position
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+const (
+	// Calendar feed types
+	CalendarFeedTypeTodo  = "todo"
+	CalendarFeedTypeEvent = "event"
+)
+
+type CalendarFeedParam struct {
+	Token string `uri:"token"` // The secret token, the .ics suffix is optional
+	Type  string `form:"type"` //Enum(todo, event)
+}
+
+type TaskCalendarParam struct {
+	UserID int64
+	From   time.Time
+	To     time.Time
+}
+
+type TaskCalendarEvent struct {
+	Task       Task
+	DueTime    time.Time
+	Occurrence bool // Future occurrence of a recurring task, it is not created as a task yet
+}
+
+type Calendar struct {
+	Name   string
+	Events []TaskCalendarEvent
+}
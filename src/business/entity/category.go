@@ -6,29 +6,34 @@ import (
 )
 
 type Category struct {
-	ID        int64       `db:"id" json:"id"`
-	Name      string      `db:"name" json:"name"`
-	Status    null.Int64  `db:"status" json:"status" swaggertype:"integer"`
-	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	CreatedBy null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
-	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	UpdatedBy null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
-	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	DeletedBy null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+	ID          int64       `db:"id" json:"id"`
+	WorkspaceID null.Int64  `db:"fk_workspace_id" json:"workspaceId" swaggertype:"integer"` // Empty on the global category
+	Name        string      `db:"name" json:"name"`
+	Status      null.Int64  `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt   null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy   null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt   null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt   null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
 }
 
 type CategoryParam struct {
-	ID   null.Int64  `param:"id" uri:"category_id" db:"id" form:"id"`
-	IDs  []int64     `param:"ids" uri:"category_ids" db:"id" form:"categoryIds"`
-	Name null.String `param:"name" db:"name"`
+	ID                 null.Int64  `param:"id" uri:"category_id" db:"id" form:"id"`
+	IDs                []int64     `param:"ids" uri:"category_ids" db:"id" form:"categoryIds"`
+	Name               null.String `param:"name" db:"name"`
+	WorkspaceID        null.Int64  `param:"fk_workspace_id" db:"fk_workspace_id" form:"workspaceId"`
+	RestrictWorkspace  bool        `db:"-"` // Only the global categories and the categories of AccessWorkspaceIDs
+	AccessWorkspaceIDs []int64     `db:"-"`
 	PaginationParam
 	QueryOption query.Option
 }
 
 type CreateCategoryParam struct {
-	Name      string      `db:"name" json:"name"`
-	CreatedBy null.String `json:"-" db:"created_by" swaggertype:"string"`
-	UpdatedBy null.String `json:"-" db:"updated_by" swaggertype:"string"`
+	WorkspaceID null.Int64  `db:"fk_workspace_id" json:"workspaceId" swaggertype:"integer"` // Empty to create a global category
+	Name        string      `db:"name" json:"name"`
+	CreatedBy   null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy   null.String `json:"-" db:"updated_by" swaggertype:"string"`
 }
 
 type UpdateCategoryParam struct {
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+const (
+	// Focus session states
+	FocusSessionStateRunning     = "running"
+	FocusSessionStateCompleted   = "completed"
+	FocusSessionStateInterrupted = "interrupted"
+
+	// Focus session phases, the break follows the completed work part
+	FocusSessionPhaseWork  = "work"
+	FocusSessionPhaseBreak = "break"
+	FocusSessionPhaseOver  = "over"
+)
+
+type FocusSession struct {
+	ID              int64       `db:"id" json:"id"`
+	TaskID          int64       `db:"fk_task_id" json:"taskId"`
+	UserID          int64       `db:"fk_user_id" json:"userId"`
+	WorkMinutes     int64       `db:"work_minutes" json:"workMinutes"`
+	BreakMinutes    int64       `db:"break_minutes" json:"breakMinutes"`
+	State           string      `db:"state" json:"state"` //Enum(running, completed, interrupted)
+	Phase           string      `db:"-" json:"phase"`     //Enum(work, break, over)
+	StartedAt       null.Time   `db:"started_at" json:"startedAt" swaggertype:"string" example:"2022-06-21T10:00:00Z"`
+	EndsAt          null.Time   `db:"ends_at" json:"endsAt" swaggertype:"string" example:"2022-06-21T10:25:00Z"` // End of the work part
+	BreakEndsAt     null.Time   `db:"-" json:"breakEndsAt" swaggertype:"string" example:"2022-06-21T10:30:00Z"`
+	EndedAt         null.Time   `db:"ended_at" json:"endedAt" swaggertype:"string" example:"2022-06-21T10:25:00Z"`
+	FocusSeconds    int64       `db:"focus_seconds" json:"focusSeconds"`
+	InterruptReason string      `db:"interrupt_reason" json:"interruptReason"`
+	Status          int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt       null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy       null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt       null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy       null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt       null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy       null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type FocusSessionParam struct {
+	ID           null.Int64 `param:"id" uri:"focus_session_id" db:"id"`
+	TaskID       null.Int64 `param:"fk_task_id" db:"fk_task_id" form:"taskId"`
+	UserID       null.Int64 `param:"fk_user_id" db:"fk_user_id"`
+	State        string     `param:"state" db:"state" form:"state"`
+	EndedAtGTE   null.Time  `param:"ended_at__gte" db:"ended_at"`
+	StartedAtGTE null.Time  `param:"started_at__gte" db:"started_at"`
+	StartedAtLT  null.Time  `param:"started_at__lt" db:"started_at"`
+	Status       null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateFocusSessionParam struct {
+	TaskID       int64       `db:"fk_task_id" json:"-"`
+	UserID       int64       `db:"fk_user_id" json:"-"`
+	WorkMinutes  int64       `db:"work_minutes" json:"workMinutes"`   // Default is taken from the config, e.g. 25
+	BreakMinutes int64       `db:"break_minutes" json:"breakMinutes"` // Default is taken from the config, e.g. 5
+	State        string      `db:"state" json:"-"`
+	StartedAt    null.Time   `db:"started_at" json:"-"`
+	EndsAt       null.Time   `db:"ends_at" json:"-"`
+	CreatedBy    null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy    null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+type UpdateFocusSessionParam struct {
+	State           string      `param:"state" db:"state" json:"-"`
+	EndedAt         null.Time   `param:"ended_at" db:"ended_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	FocusSeconds    null.Int64  `param:"focus_seconds" db:"focus_seconds" json:"-"`
+	InterruptReason string      `param:"interrupt_reason" db:"interrupt_reason" json:"-"`
+	Status          null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt       null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy       null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt       null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy       null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+type InterruptFocusSessionParam struct {
+	Reason string `json:"reason"` // Optional, e.g. meeting
+}
+
+type FocusReportQuery struct {
+	From     string `form:"from"`     // Date (2006-01-02) or RFC 3339 time
+	To       string `form:"to"`       // Date (2006-01-02) or RFC 3339 time, the whole day is included when only the date is given
+	Timezone string `form:"timezone"` // IANA time zone of the days, default is UTC
+}
+
+type FocusReportParam struct {
+	UserID   int64
+	From     time.Time      // Inclusive
+	To       time.Time      // Exclusive
+	Location *time.Location // Location of the days
+}
+
+type FocusDay struct {
+	Day          string `db:"day" json:"day"` // 2006-01-02
+	FocusSeconds int64  `db:"focus_seconds" json:"-"`
+	FocusMinutes int64  `db:"-" json:"focusMinutes"`
+	Completed    int64  `db:"completed" json:"completed"`
+	Interrupted  int64  `db:"interrupted" json:"interrupted"`
+}
+
+// FocusTotal is the focus time and the completed sessions of the user since the first session
+type FocusTotal struct {
+	FocusSeconds int64 `db:"focus_seconds"`
+	Completed    int64 `db:"completed"`
+}
+
+// ProcessBreak fill the end of the break which follows the work part
+func (f *FocusSession) ProcessBreak() {
+	if f.EndsAt.Valid {
+		f.BreakEndsAt = null.TimeFrom(f.EndsAt.Time.Add(time.Duration(f.BreakMinutes) * time.Minute))
+	}
+}
+
+// ProcessPhase compute the phase of the session at the given time, only the completed session has a break
+func (f *FocusSession) ProcessPhase(now time.Time) {
+	switch {
+	case f.State == FocusSessionStateRunning:
+		f.Phase = FocusSessionPhaseWork
+	case f.State == FocusSessionStateCompleted && now.Before(f.BreakEndsAt.Time):
+		f.Phase = FocusSessionPhaseBreak
+	default:
+		f.Phase = FocusSessionPhaseOver
+	}
+}
@@ -0,0 +1,241 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// Habit is a daily or weekly periodic task which is checked in instead of being done, the first task of the series
+// is the habit, so the check ins are kept when the task spawned its next occurrences
+type Habit struct {
+	ID             int64     `json:"id"` // Id of the first task of the series
+	Title          string    `json:"title"`
+	Description    string    `json:"description"`
+	Periodic       string    `json:"periodic"`    //Enum(daily, weekly)
+	StartedOn      string    `json:"startedOn"`   // 2006-01-02, day the task was created
+	CheckedIn      bool      `json:"checkedIn"`   // Checked in today or this week
+	LastCheckIn    string    `json:"lastCheckIn"` // 2006-01-02, empty when never checked in
+	TotalCheckIns  int64     `json:"totalCheckIns"`
+	CurrentStreak  int64     `json:"currentStreak"` // Consecutive days or weeks, the current one is not missed until it is over
+	LongestStreak  int64     `json:"longestStreak"`
+	CompletionRate float64   `json:"completionRate"` // Checked in days or weeks in percent since the habit started
+	CreatedAt      null.Time `json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+}
+
+type HabitParam struct {
+	ID       null.Int64 `uri:"habit_id"`
+	Periodic string     `form:"periodic"` // Only the daily or weekly habits
+	Timezone string     `form:"timezone"` // IANA time zone of the days, default is UTC
+	PaginationParam
+}
+
+type HabitCheckIn struct {
+	ID          int64       `db:"id" json:"id"`
+	TaskID      int64       `db:"fk_task_id" json:"habitId"`
+	UserID      int64       `db:"fk_user_id" json:"userId"`
+	CheckInDate null.Date   `db:"checkin_date" json:"checkInDate" swaggertype:"string" example:"2022-06-21T00:00:00Z"`
+	Status      int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt   null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy   null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt   null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt   null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type HabitCheckInParam struct {
+	ID          null.Int64 `param:"id" db:"id"`
+	TaskID      null.Int64 `param:"fk_task_id" db:"fk_task_id"`
+	TaskIDs     []int64    `param:"fk_task_ids" db:"fk_task_id"`
+	CheckInDate null.Date  `param:"checkin_date" db:"checkin_date"`
+	Status      null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateHabitCheckInParam struct {
+	TaskID      int64       `db:"fk_task_id"`
+	UserID      int64       `db:"fk_user_id"`
+	CheckInDate null.Date   `db:"checkin_date"`
+	CreatedBy   null.String `db:"created_by"`
+	UpdatedBy   null.String `db:"updated_by"`
+}
+
+type UpdateHabitCheckInParam struct {
+	Status    null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+// HabitCheckInRequest is the day to check in or to undo, the default is today
+type HabitCheckInRequest struct {
+	Date     string `json:"date" form:"date"`         // 2006-01-02
+	Timezone string `json:"timezone" form:"timezone"` // IANA time zone of today, default is UTC
+}
+
+type HabitHistoryQuery struct {
+	From     string `form:"from"`     // Date (2006-01-02) or RFC 3339 time
+	To       string `form:"to"`       // Date (2006-01-02) or RFC 3339 time, the whole day is included when only the date is given
+	Timezone string `form:"timezone"` // IANA time zone of the days, default is UTC
+}
+
+type HabitHistoryParam struct {
+	ID       null.Int64     // Id of any task in the series
+	From     time.Time      // Inclusive
+	To       time.Time      // Exclusive
+	Location *time.Location // Location of the days
+}
+
+// HabitHistory is the heatmap of the habit, one entry per day of the range
+type HabitHistory struct {
+	Habit          Habit      `json:"habit"`
+	From           string     `json:"from"` // 2006-01-02
+	To             string     `json:"to"`   // 2006-01-02, inclusive
+	Days           []HabitDay `json:"days"`
+	CheckIns       int64      `json:"checkIns"`
+	CompletionRate float64    `json:"completionRate"` // Checked in days or weeks in percent within the range
+}
+
+type HabitDay struct {
+	Date      string `json:"date"` // 2006-01-02
+	CheckedIn bool   `json:"checkedIn"`
+	Level     int64  `json:"level"` // Heatmap level, 0 is empty, 1 is the checked in week of a weekly habit and 2 is the checked in day
+}
+
+// HabitPeriodStart return the start of the day of the daily habit or the start of the ISO week of the weekly habit
+func HabitPeriodStart(periodic string, day time.Time) time.Time {
+	day = dayOf(day)
+	if periodic == TaskPeriodicWeekly {
+		// Monday is the first day of the ISO week
+		day = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+
+	return day
+}
+
+// HabitNextPeriod return the start of the period after the one starting at start
+func HabitNextPeriod(periodic string, start time.Time) time.Time {
+	if periodic == TaskPeriodicWeekly {
+		return start.AddDate(0, 0, 7)
+	}
+
+	return start.AddDate(0, 0, 1)
+}
+
+// HabitCountPeriods return the number of periods from the period of from up to and including the period of to
+func HabitCountPeriods(periodic string, from, to time.Time) int64 {
+	from, to = HabitPeriodStart(periodic, from), HabitPeriodStart(periodic, to)
+	if to.Before(from) {
+		return 0
+	}
+
+	days := daysBetween(from, to)
+	if periodic == TaskPeriodicWeekly {
+		return days/7 + 1
+	}
+
+	return days + 1
+}
+
+// ProcessStats fill the streaks and the completion rate from the check in days, today is the day in the time zone
+// of the user. The current period which is not checked in yet does not break the streak
+func (h *Habit) ProcessStats(days []time.Time, startedOn, today time.Time) {
+	periods := map[time.Time]bool{}
+	var last time.Time
+	for _, day := range days {
+		periods[HabitPeriodStart(h.Periodic, day)] = true
+		if day.After(last) {
+			last = dayOf(day)
+		}
+		// The habit is started on the first check in when it was checked in before the task was created
+		if dayOf(day).Before(dayOf(startedOn)) {
+			startedOn = day
+		}
+	}
+
+	h.StartedOn = dayOf(startedOn).Format(ConstLayoutDateFormat)
+	h.TotalCheckIns = int64(len(days))
+	if !last.IsZero() {
+		h.LastCheckIn = last.Format(ConstLayoutDateFormat)
+	}
+
+	current := HabitPeriodStart(h.Periodic, today)
+	h.CheckedIn = periods[current]
+
+	// Count back from the current period, or the previous one when the current is still open
+	h.CurrentStreak = 0
+	period := current
+	if !h.CheckedIn {
+		period = previousHabitPeriod(h.Periodic, current)
+	}
+	for periods[period] {
+		h.CurrentStreak++
+		period = previousHabitPeriod(h.Periodic, period)
+	}
+
+	h.LongestStreak = longestHabitStreak(h.Periodic, periods)
+	h.CompletionRate = habitCompletionRate(h.Periodic, periods, startedOn, today)
+}
+
+func previousHabitPeriod(periodic string, start time.Time) time.Time {
+	if periodic == TaskPeriodicWeekly {
+		return start.AddDate(0, 0, -7)
+	}
+
+	return start.AddDate(0, 0, -1)
+}
+
+func longestHabitStreak(periodic string, periods map[time.Time]bool) int64 {
+	var longest int64
+	for period := range periods {
+		// Only count from the first period of every streak
+		if periods[previousHabitPeriod(periodic, period)] {
+			continue
+		}
+
+		var streak int64
+		for p := period; periods[p]; p = HabitNextPeriod(periodic, p) {
+			streak++
+		}
+
+		if streak > longest {
+			longest = streak
+		}
+	}
+
+	return longest
+}
+
+// habitCompletionRate return the checked in periods from the period of from to the period of to in percent
+func habitCompletionRate(periodic string, periods map[time.Time]bool, from, to time.Time) float64 {
+	total := HabitCountPeriods(periodic, from, to)
+	if total < 1 {
+		return 0
+	}
+
+	start, end := HabitPeriodStart(periodic, from), HabitPeriodStart(periodic, to)
+
+	var checked int64
+	for period := range periods {
+		if !period.Before(start) && !period.After(end) {
+			checked++
+		}
+	}
+
+	return math.Round(float64(checked)*10000/float64(total)) / 100
+}
+
+// HabitRangeCompletionRate return the checked in periods within the days from and to in percent
+func HabitRangeCompletionRate(periodic string, days []time.Time, from, to time.Time) float64 {
+	periods := map[time.Time]bool{}
+	for _, day := range days {
+		periods[HabitPeriodStart(periodic, day)] = true
+	}
+
+	return habitCompletionRate(periodic, periods, from, to)
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Metrics which the users are ranked by
+	LeaderboardMetricXP    = "xp"
+	LeaderboardMetricTasks = "tasks" // Number of the done tasks
+
+	// Periods of the leaderboard, the weekly one starts on Monday
+	LeaderboardPeriodWeekly  = "weekly"
+	LeaderboardPeriodAllTime = "alltime"
+
+	// Scopes of the leaderboard, the team leaderboard is the workspace scope which rank only the members of the workspace
+	LeaderboardScopeGlobal    = "global"
+	LeaderboardScopeWorkspace = "workspace"
+)
+
+// LeaderboardKey identify one leaderboard, every leaderboard is a sorted set in redis
+type LeaderboardKey struct {
+	Scope  string
+	Metric string
+	Period string
+	Week   time.Time // Any time in the week of the weekly leaderboard
+}
+
+// String return the redis key of the leaderboard, the weekly key is suffixed with its ISO week
+func (k LeaderboardKey) String() string {
+	key := fmt.Sprintf("leaderboard:%s:%s:%s", k.Scope, k.Metric, k.Period)
+	if k.Period == LeaderboardPeriodWeekly {
+		year, week := k.Week.ISOWeek()
+		key = fmt.Sprintf("%s:%d-W%02d", key, year, week)
+	}
+
+	return key
+}
+
+type LeaderboardParam struct {
+	Scope       string `form:"scope"`
+	Metric      string `form:"metric"`
+	Period      string `form:"period"`
+	WorkspaceID int64  `form:"workspaceId"` // Required by the workspace scope
+	PaginationParam
+}
+
+type LeaderboardEntry struct {
+	Rank        int64  `json:"rank"` // Zero when the user is not on the leaderboard
+	UserID      int64  `json:"userId"`
+	Username    string `json:"username"`
+	DisplayName string `json:"displayName"`
+	Score       int64  `json:"score"`
+}
+
+type LeaderboardSettings struct {
+	OptOut bool `json:"optOut"` // The user is hidden from every leaderboard
+}
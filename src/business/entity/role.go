@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"context"
+
+	"github.com/adiatma85/own-go-sdk/appcontext"
 	"github.com/adiatma85/own-go-sdk/null"
 	"github.com/adiatma85/own-go-sdk/query"
 )
@@ -13,6 +16,21 @@ const (
 	RoleTypeUser  = "user"
 )
 
+// roleIDKey is the context key of the role of the user of the request
+type roleIDKey struct{}
+
+// SetUserRoleID store the role of the user of the request, it is set next to the user id once the user is verified
+func SetUserRoleID(ctx context.Context, roleID int64) context.Context {
+	return context.WithValue(ctx, roleIDKey{}, roleID)
+}
+
+// IsSuperAdmin return true when the user is the user of the request and that user has the super admin role
+func IsSuperAdmin(ctx context.Context, userID int64) bool {
+	roleID, _ := ctx.Value(roleIDKey{}).(int64)
+
+	return roleID == RoleIdSuperAdmin && int64(appcontext.GetUserId(ctx)) == userID
+}
+
 type Role struct {
 	ID        int64       `db:"id" json:"id"`
 	Name      string      `db:"name" json:"name"`
@@ -0,0 +1,26 @@
+package entity
+
+import "github.com/adiatma85/own-go-sdk/null"
+
+const (
+	// Search hit types
+	SearchTypeTask     = "task"
+	SearchTypeComment  = "comment"
+	SearchTypeCategory = "category"
+)
+
+type SearchParam struct {
+	Query              string     `form:"q"`
+	AccessUserID       null.Int64 `form:"-"` // Only the hits of the tasks owned by or assigned to the user, or shared in AccessWorkspaceIDs. Empty for admin
+	AccessWorkspaceIDs []int64    `form:"-"` // The category hits are limited to the global categories and the ones of these workspaces
+	PaginationParam
+}
+
+type SearchResult struct {
+	Type    string     `db:"type" json:"type"` //Enum(task, comment, category)
+	ID      int64      `db:"id" json:"id"`
+	TaskID  null.Int64 `db:"task_id" json:"taskId" swaggertype:"integer"` // The task of the hit, empty for category
+	Title   string     `db:"title" json:"title"`
+	Snippet string     `db:"snippet" json:"snippet"`
+	Score   float64    `db:"score" json:"score"`
+}
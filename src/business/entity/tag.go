@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+const (
+	// Tag filter modes
+	TagFilterAny = "any"
+	TagFilterAll = "all"
+)
+
+type Tag struct {
+	ID        int64       `db:"id" json:"id"`
+	UserID    int64       `db:"fk_user_id" json:"userId"`
+	Name      string      `db:"name" json:"name"`
+	Status    int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type TagParam struct {
+	ID     null.Int64  `param:"id" uri:"tag_id" db:"id"`
+	IDs    []int64     `param:"ids" db:"id"`
+	UserID null.Int64  `param:"fk_user_id" db:"fk_user_id"`
+	Name   null.String `param:"name" db:"name" form:"name"`
+	Names  []string    `param:"names" db:"name"`
+	Status null.Int64  `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateTagParam struct {
+	UserID    int64       `db:"fk_user_id" json:"-"`
+	Name      string      `db:"name" json:"name"`
+	CreatedBy null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+type UpdateTagParam struct {
+	Name      string      `param:"name" db:"name" json:"name"`
+	Status    null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+type TaskTag struct {
+	ID        int64       `db:"id" json:"id"`
+	TaskID    int64       `db:"fk_task_id" json:"taskId"`
+	TagID     int64       `db:"fk_tag_id" json:"tagId"`
+	Status    int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type TaskTagParam struct {
+	ID      null.Int64 `param:"id" db:"id"`
+	TaskID  null.Int64 `param:"fk_task_id" uri:"task_id" db:"fk_task_id"`
+	TaskIDs []int64    `param:"fk_task_ids" db:"fk_task_id"`
+	TagID   null.Int64 `param:"fk_tag_id" uri:"tag_id" db:"fk_tag_id"`
+	TagIDs  []int64    `param:"fk_tag_ids" db:"fk_tag_id"`
+	Status  null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateTaskTagParam struct {
+	TaskID    int64       `db:"fk_task_id" json:"-"`
+	TagID     int64       `db:"fk_tag_id" json:"tagId"`
+	CreatedBy null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+type UpdateTaskTagParam struct {
+	Status    null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
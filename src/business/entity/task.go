@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"math"
+	"time"
+
 	"github.com/adiatma85/own-go-sdk/null"
 	"github.com/adiatma85/own-go-sdk/query"
 )
@@ -17,64 +20,193 @@ const (
 	TaskPeriodicWeekly  = "weekly"
 	TaskPeriodicMonthly = "monthly"
 	TaskPeriodicYearly  = "yearly"
+
+	// Bulk task actions
+	TaskBulkActionStatus   = "status"
+	TaskBulkActionCategory = "category"
+	TaskBulkActionPriority = "priority"
+	TaskBulkActionDelete   = "delete"
 )
 
 type Task struct {
-	ID         int64       `db:"id" json:"id"`
-	UserId     int64       `db:"fk_user_id" json:"userId"`
-	CategoryID null.Int64  `db:"fk_category_id" json:"categoryId"`
-	Title      string      `db:"title" json:"title"`
-	Priority   int64       `db:"priority" json:"priority"`
-	TaskStatus string      `db:"task_status" json:"taskStatus"` //Enum(todo, ongoing, done)
-	Periodic   string      `db:"periodic" json:"periodic"`      //Enum(none, daily, weekly, monthly, yearly)
-	DueTime    null.Time   `db:"due_time" json:"dueTime"`
-	Status     int64       `db:"status" json:"status" swaggertype:"integer"`
-	CreatedAt  null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	CreatedBy  null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
-	UpdatedAt  null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	UpdatedBy  null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
-	DeletedAt  null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	DeletedBy  null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+	ID                   int64       `db:"id" json:"id"`
+	UserId               int64       `db:"fk_user_id" json:"userId"` // Owner of the task
+	AssigneeID           null.Int64  `db:"fk_assignee_id" json:"assigneeId" swaggertype:"integer"`
+	WorkspaceID          null.Int64  `db:"fk_workspace_id" json:"workspaceId" swaggertype:"integer"` // Empty on the personal task
+	CategoryID           null.Int64  `db:"fk_category_id" json:"categoryId"`
+	SeriesID             null.Int64  `db:"fk_series_id" json:"seriesId"`
+	ParentID             null.Int64  `db:"fk_parent_task_id" json:"parentTaskId"`
+	Title                string      `db:"title" json:"title"`
+	Description          string      `db:"description" json:"description"`
+	Priority             int64       `db:"priority" json:"priority"`
+	TaskStatus           string      `db:"task_status" json:"taskStatus"` //Enum(todo, ongoing, done)
+	Periodic             string      `db:"periodic" json:"periodic"`      //Enum(none, daily, weekly, monthly, yearly)
+	RRule                string      `db:"rrule" json:"rrule"`            // RFC 5545 recurrence rule, e.g. FREQ=MONTHLY;BYDAY=2TU
+	DueTime              null.Time   `db:"due_time" json:"dueTime"`
+	AutoComplete         bool        `db:"auto_complete" json:"autoComplete"` // Mark the task as done once all of its subtasks are done, todo task goes through ongoing
+	Position             string      `db:"position" json:"position"`          // Rank key of the task, sort ascending for the manual order
+	SubtaskCount         int64       `db:"subtask_count" json:"subtaskCount"`
+	SubtaskDoneCount     int64       `db:"subtask_done_count" json:"subtaskDoneCount"`
+	CompletionPercentage float64     `db:"-" json:"completionPercentage"`
+	Tags                 []Tag       `db:"-" json:"tags"`
+	Status               int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt            null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy            null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt            null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy            null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt            null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy            null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
 }
 
 type TaskParam struct {
-	ID         null.Int64  `param:"id" uri:"task_id" db:"id" form:"task_id"`
-	IDs        []int64     `param:"ids" uri:"task_ids" db:"id"`
-	UserId     null.Int64  `param:"fk_user_id" uri:"user_id" db:"fk_user_id"`
-	CategoryID null.Int64  `param:"fk_category_id" uri:"category_id" db:"fk_category_id"`
-	Title      null.String `param:"title" db:"title"`
-	Priority   null.Int64  `param:"priority" db:"priority"`                         //Enum(none, daily, weekly, monthly, yearly)
-	TaskStatus string      `param:"task_status" db:"task_status" form:"taskStatus"` //Enum(todo, ongoing, done)
-	Periodic   null.String `param:"periodic" db:"periodic"`
-	DueTime    null.Time   `param:"due_time" db:"due_time"`
-	Status     null.Int64  `param:"status" db:"status" swaggertype:"string"`
+	ID                 null.Int64  `param:"id" uri:"task_id" db:"id" form:"task_id"`
+	IDs                []int64     `param:"ids" uri:"task_ids" db:"id"`
+	UserId             null.Int64  `param:"fk_user_id" uri:"user_id" db:"fk_user_id"`
+	AssigneeID         null.Int64  `param:"fk_assignee_id" db:"fk_assignee_id"`
+	AccessUserID       null.Int64  `db:"-"` // Only the tasks owned by or assigned to the user, or shared in AccessWorkspaceIDs
+	AccessWorkspaceIDs []int64     `db:"-"`
+	WorkspaceID        null.Int64  `param:"fk_workspace_id" db:"fk_workspace_id" form:"workspaceId"`
+	AssignedToMe       bool        `db:"-"` // Only the tasks assigned to the user, applied by the usecase
+	CategoryID         null.Int64  `param:"fk_category_id" uri:"category_id" db:"fk_category_id"`
+	CategoryIDs        []int64     `param:"fk_category_ids" db:"fk_category_id"`
+	SeriesID           null.Int64  `param:"fk_series_id" db:"fk_series_id" form:"seriesId"`
+	ParentID           null.Int64  `param:"fk_parent_task_id" db:"fk_parent_task_id" form:"parentTaskId"`
+	ParentIDs          []int64     `param:"fk_parent_task_ids" db:"fk_parent_task_id"`
+	TopLevelOnly       bool        `db:"-" form:"topLevelOnly"` // Exclude the subtasks from the result
+	SeriesOnly         bool        `db:"-"`                     // Only the first task of every series, the spawned occurrences are excluded
+	PersonalOnly       bool        `db:"-"`                     // Only the personal tasks, the tasks of the workspaces are excluded
+	Tags               string      `db:"-" form:"tags"`         // Tag filter, e.g. any:urgent,client-x or all:urgent,client-x
+	Overdue            bool        `db:"-"`                     // Only the unfinished tasks which are past their due time
+	RecurringOnly      bool        `db:"-"`                     // Only the tasks with a recurrence rule or a period
+	Title              null.String `param:"title" db:"title"`
+	Priority           null.Int64  `param:"priority" db:"priority"` //Enum(none, daily, weekly, monthly, yearly)
+	PriorityGTE        null.Int64  `param:"priority__gte" db:"priority"`
+	TaskStatus         string      `param:"task_status" db:"task_status"` //Enum(todo, ongoing, done)
+	TaskStatuses       []string    `param:"task_statuses" db:"task_status"`
+	TaskStatusNE       string      `param:"task_status__ne" db:"task_status"`
+	Periodic           null.String `param:"periodic" db:"periodic"`
+	Periodics          []string    `param:"periodics" db:"periodic"`
+	DueTime            null.Time   `param:"due_time" db:"due_time"`
+	DueTimeGTE         null.Time   `param:"due_time__gte" db:"due_time"`
+	DueTimeLTE         null.Time   `param:"due_time__lte" db:"due_time"`
+	DueTimeLT          null.Time   `param:"due_time__lt" db:"due_time"`
+	Position           null.String `param:"position" db:"position"`
+	PositionGT         null.String `param:"position__gt" db:"position"`
+	PositionLT         null.String `param:"position__lt" db:"position"`
+	CreatedAt          null.Time   `param:"created_at" db:"created_at"`
+	UpdatedAt          null.Time   `param:"updated_at" db:"updated_at"`
+	Status             null.Int64  `param:"status" db:"status" swaggertype:"string"`
 	PaginationParam
 	QueryOption query.Option
 }
 
+// TaskListQuery is the query string of the task list which is validated by the handler before it is applied to TaskParam
+type TaskListQuery struct {
+	TaskStatus   []string `form:"taskStatus"`  // Comma separated or repeated, e.g. todo,ongoing
+	CategoryID   []string `form:"categoryId"`  // Comma separated or repeated, e.g. 1,2
+	PriorityMin  string   `form:"priorityMin"` // Minimum priority, inclusive
+	DueFrom      string   `form:"dueFrom"`     // Date (2006-01-02) or RFC 3339 time, inclusive
+	DueTo        string   `form:"dueTo"`       // Date (2006-01-02) or RFC 3339 time, inclusive
+	Overdue      bool     `form:"overdue"`
+	AssignedToMe bool     `form:"assignedToMe"` // Only the tasks assigned to the user
+	SortBy       []string `form:"sortBy"`       // Comma separated or repeated, prefix with - for descending, e.g. -priority,dueTime
+}
+
 type CreateTaskParam struct {
-	UserId     int64       `db:"fk_user_id" json:"-"`
-	CategoryID int64       `db:"fk_category_id" json:"categoryId"`
-	Title      string      `db:"title" json:"title"`
-	Priority   int64       `db:"priority" json:"priority"`
-	TaskStatus string      `db:"task_status" json:"taskStatus"` //Enum(todo, ongoing, done)
-	Periodic   string      `db:"periodic" json:"periodic"`      //Enum(none, daily, weekly, monthly, yearly)
-	DueTime    null.Time   `db:"due_time" json:"due_time"`
-	CreatedBy  null.String `json:"-" db:"created_by" swaggertype:"string"`
-	UpdatedBy  null.String `json:"-" db:"updated_by" swaggertype:"string"`
+	UserId       int64       `db:"fk_user_id" json:"-"`
+	AssigneeID   null.Int64  `db:"fk_assignee_id" json:"assigneeId" swaggertype:"integer"`
+	WorkspaceID  null.Int64  `db:"fk_workspace_id" json:"workspaceId" swaggertype:"integer"`
+	CategoryID   int64       `db:"fk_category_id" json:"categoryId"`
+	SeriesID     null.Int64  `db:"fk_series_id" json:"-"`
+	ParentID     null.Int64  `db:"fk_parent_task_id" json:"parentTaskId" swaggertype:"integer"`
+	Title        string      `db:"title" json:"title"`
+	Description  string      `db:"description" json:"description"`
+	Priority     int64       `db:"priority" json:"priority"`
+	TaskStatus   string      `db:"task_status" json:"taskStatus"` //Enum(todo, ongoing, done)
+	Periodic     string      `db:"periodic" json:"periodic"`      //Enum(none, daily, weekly, monthly, yearly)
+	RRule        string      `db:"rrule" json:"rrule"`
+	DueTime      null.Time   `db:"due_time" json:"due_time"`
+	AutoComplete bool        `db:"auto_complete" json:"autoComplete"`
+	Position     string      `db:"position" json:"-"` // Default is after the last task
+	CreatedBy    null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy    null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+// MoveTaskParam is the anchors of the task after it is moved, at least one of them is required
+type MoveTaskParam struct {
+	Before null.Int64 `json:"before" swaggertype:"integer"` // Task which comes right after the moved task
+	After  null.Int64 `json:"after" swaggertype:"integer"`  // Task which comes right before the moved task
+}
+
+// AssignTaskParam is the user the task is assigned to
+type AssignTaskParam struct {
+	AssigneeID int64 `json:"assigneeId"`
 }
 
 type UpdateTaskParam struct {
-	UserId     null.Int64  `param:"fk_user_id" db:"fk_user_id" json:"-"`
-	CategoryID null.Int64  `param:"fk_category_id" db:"fk_category_id" json:"categoryId"`
-	Title      string      `param:"title" db:"title" json:"title"`
-	Priority   int64       `param:"priority" db:"priority" json:"priority"`         //Enum(none, daily, weekly, monthly, yearly)
-	TaskStatus string      `param:"task_status" db:"task_status" json:"taskStatus"` //Enum(todo, ongoing, done)
-	Periodic   null.String `db:"periodic" param:"periodic" json:"periodic"`
-	DueTime    null.Time   `db:"due_time" json:"dueTime" param:"due_time"`
-	Status     null.Int64  `db:"status" param:"status" json:"-" swaggertype:"string"`
-	UpdatedAt  null.Time   `db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	UpdatedBy  null.String `db:"updated_by" json:"-" swaggertype:"string"`
-	DeletedAt  null.Time   `db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	DeletedBy  null.String `db:"deleted_by" json:"-" swaggertype:"string"`
+	UserId       null.Int64  `param:"fk_user_id" db:"fk_user_id" json:"-"`
+	CategoryID   null.Int64  `param:"fk_category_id" db:"fk_category_id" json:"categoryId"`
+	Title        string      `param:"title" db:"title" json:"title"`
+	Description  null.String `param:"description" db:"description" json:"description" swaggertype:"string"`
+	Priority     int64       `param:"priority" db:"priority" json:"priority"`         //Enum(none, daily, weekly, monthly, yearly)
+	TaskStatus   string      `param:"task_status" db:"task_status" json:"taskStatus"` //Enum(todo, ongoing, done)
+	Periodic     null.String `db:"periodic" param:"periodic" json:"periodic"`
+	RRule        null.String `db:"rrule" param:"rrule" json:"rrule" swaggertype:"string"`
+	DueTime      null.Time   `db:"due_time" json:"dueTime" param:"due_time"`
+	AutoComplete null.Bool   `db:"auto_complete" param:"auto_complete" json:"autoComplete" swaggertype:"boolean"`
+	Position     null.String `db:"position" param:"position" json:"-" swaggertype:"string"`
+	Status       null.Int64  `db:"status" param:"status" json:"-" swaggertype:"string"`
+	UpdatedAt    null.Time   `db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy    null.String `db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt    null.Time   `db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy    null.String `db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+// ProcessCompletion compute the completion percentage of the task from its subtasks
+func (t *Task) ProcessCompletion() {
+	if t.SubtaskCount < 1 {
+		t.CompletionPercentage = 0
+		return
+	}
+
+	t.CompletionPercentage = math.Round(float64(t.SubtaskDoneCount)*10000/float64(t.SubtaskCount)) / 100
+}
+
+type TaskOccurrenceParam struct {
+	ID   int64     `uri:"task_id"`
+	From time.Time `form:"from" time_format:"2006-01-02"`
+	To   time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+type TaskOccurrence struct {
+	TaskID  int64     `json:"taskId"`
+	DueTime time.Time `json:"dueTime"`
+}
+
+type BulkTaskParam struct {
+	Operations []BulkTaskOperation `json:"operations"`
+}
+
+type BulkTaskOperation struct {
+	Action     string  `json:"action"` //Enum(status, category, priority, delete)
+	IDs        []int64 `json:"ids"`
+	TaskStatus string  `json:"taskStatus"` // Required by status action
+	CategoryID int64   `json:"categoryId"` // Required by category action
+	Priority   int64   `json:"priority"`   // Required by priority action
+}
+
+type BulkTaskResult struct {
+	Operation int    `json:"operation"` // Index of the operation in the request
+	TaskID    int64  `json:"taskId"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+
+	// EffectError is the side effect of the saved status change which failed, e.g. the next occurrence was not spawned
+	EffectError string `json:"effectError,omitempty"`
+}
+
+// TaskBulkUpdate is a single update executed inside the bulk transaction
+type TaskBulkUpdate struct {
+	UpdateParam UpdateTaskParam
+	SelectParam TaskParam
 }
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"io"
+
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type TaskAttachment struct {
+	ID         int64       `db:"id" json:"id"`
+	TaskID     int64       `db:"fk_task_id" json:"taskId"`
+	UserID     int64       `db:"fk_user_id" json:"userId"`
+	FileName   string      `db:"file_name" json:"fileName"`
+	MimeType   string      `db:"mime_type" json:"mimeType"`
+	Size       int64       `db:"size" json:"size"`
+	Checksum   string      `db:"checksum" json:"checksum"` // SHA-256 of the file content in hex
+	StorageKey string      `db:"storage_key" json:"-"`
+	Status     int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt  null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy  null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt  null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy  null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt  null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy  null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type TaskAttachmentParam struct {
+	ID     null.Int64 `param:"id" uri:"attachment_id" db:"id"`
+	TaskID null.Int64 `param:"fk_task_id" uri:"task_id" db:"fk_task_id"`
+	Status null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateTaskAttachmentParam struct {
+	TaskID     int64       `db:"fk_task_id"`
+	UserID     int64       `db:"fk_user_id"`
+	FileName   string      `db:"file_name"`
+	MimeType   string      `db:"mime_type"`
+	Size       int64       `db:"size"`
+	Checksum   string      `db:"checksum"`
+	StorageKey string      `db:"storage_key"`
+	Content    io.Reader   `db:"-"`
+	CreatedBy  null.String `db:"created_by"`
+	UpdatedBy  null.String `db:"updated_by"`
+}
+
+type UpdateTaskAttachmentParam struct {
+	Status    null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+// TaskAttachmentFile is the attachment metadata together with its content to be streamed
+type TaskAttachmentFile struct {
+	TaskAttachment
+	Content io.ReadSeekCloser
+}
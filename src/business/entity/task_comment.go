@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type TaskComment struct {
+	ID        int64       `db:"id" json:"id"`
+	TaskID    int64       `db:"fk_task_id" json:"taskId"`
+	UserID    int64       `db:"fk_user_id" json:"userId"`
+	Content   string      `db:"content" json:"content"`
+	Status    int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type TaskCommentParam struct {
+	ID     null.Int64 `param:"id" uri:"comment_id" db:"id"`
+	TaskID null.Int64 `param:"fk_task_id" uri:"task_id" db:"fk_task_id"`
+	UserID null.Int64 `param:"fk_user_id" db:"fk_user_id"`
+	Status null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateTaskCommentParam struct {
+	TaskID    int64       `db:"fk_task_id" json:"-"`
+	UserID    int64       `db:"fk_user_id" json:"-"`
+	Content   string      `db:"content" json:"content"`
+	CreatedBy null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+type UpdateTaskCommentParam struct {
+	Content   string      `param:"content" db:"content" json:"content"`
+	Status    null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type TaskDependency struct {
+	ID            int64       `db:"id" json:"id"`
+	TaskID        int64       `db:"fk_task_id" json:"taskId"`
+	BlockerTaskID int64       `db:"fk_blocker_task_id" json:"blockerTaskId"`
+	Status        int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt     null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy     null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt     null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy     null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt     null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy     null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type TaskDependencyParam struct {
+	ID            null.Int64 `param:"id" db:"id"`
+	TaskID        null.Int64 `param:"fk_task_id" uri:"task_id" db:"fk_task_id"`
+	TaskIDs       []int64    `param:"fk_task_ids" db:"fk_task_id"`
+	BlockerTaskID null.Int64 `param:"fk_blocker_task_id" uri:"blocker_id" db:"fk_blocker_task_id"`
+	Status        null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateTaskDependencyParam struct {
+	TaskID        int64       `db:"fk_task_id" json:"-"`
+	BlockerTaskID int64       `db:"fk_blocker_task_id" json:"blockerTaskId"`
+	CreatedBy     null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy     null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+type UpdateTaskDependencyParam struct {
+	Status    null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
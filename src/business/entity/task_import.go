@@ -0,0 +1,52 @@
+package entity
+
+import "github.com/adiatma85/own-go-sdk/null"
+
+const (
+	// Task import and export file formats
+	TaskFileFormatICS  = "ics"
+	TaskFileFormatCSV  = "csv"
+	TaskFileFormatJSON = "json"
+)
+
+// Header of the CSV export, it is also the default mapping of the CSV import
+var TaskFileCSVHeader = []string{"title", "description", "category", "priority", "taskStatus", "periodic", "rrule", "dueTime"}
+
+// TaskFileItem is a task as it is written in the JSON export and read by the JSON import
+type TaskFileItem struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Category    string    `json:"category"` // Category name
+	Priority    int64     `json:"priority"`
+	TaskStatus  string    `json:"taskStatus"` //Enum(todo, ongoing, done)
+	Periodic    string    `json:"periodic"`   //Enum(none, daily, weekly, monthly, yearly)
+	RRule       string    `json:"rrule"`
+	DueTime     null.Time `json:"dueTime" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+}
+
+type TaskImportParam struct {
+	Format  string `form:"format"`  //Enum(ics, csv, json), default is taken from the file extension
+	Mapping string `form:"mapping"` // CSV only, JSON object of task field to CSV header, e.g. {"title":"Name","dueTime":"Deadline"}
+	DryRun  bool   `form:"dryRun"`  // Only validate the file
+}
+
+type TaskExportParam struct {
+	Format string `form:"format"` //Enum(csv, json, ics)
+}
+
+type TaskImportRow struct {
+	Row      int             `json:"row"` // Record number in the file, starting from 1
+	Title    string          `json:"title"`
+	Errors   []string        `json:"errors"`
+	Task     CreateTaskParam `json:"-"`
+	Category string          `json:"-"` // Category name, it is created when it does not exist yet
+}
+
+type TaskImportResult struct {
+	DryRun        bool            `json:"dryRun"`
+	Total         int             `json:"total"`
+	Invalid       int             `json:"invalid"`
+	Created       int             `json:"created"`
+	NewCategories []string        `json:"newCategories"`
+	InvalidRows   []TaskImportRow `json:"invalidRows"`
+}
@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+const (
+	// Task reminder kinds
+	TaskReminderKindBefore = "before" // OffsetMinutes before the due time
+	TaskReminderKindAt     = "at"     // TimeOfDay on the day of the due time
+
+	TaskReminderTimeOfDayFormat = "15:04"
+)
+
+type TaskReminder struct {
+	ID            int64       `db:"id" json:"id"`
+	TaskID        int64       `db:"fk_task_id" json:"taskId"`
+	UserID        int64       `db:"fk_user_id" json:"userId"`
+	Kind          string      `db:"kind" json:"kind"` //Enum(before, at)
+	OffsetMinutes int64       `db:"offset_minutes" json:"offsetMinutes"`
+	TimeOfDay     string      `db:"time_of_day" json:"timeOfDay"`
+	Timezone      string      `db:"timezone" json:"timezone"`
+	RemindAt      null.Time   `db:"remind_at" json:"remindAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	SentAt        null.Time   `db:"sent_at" json:"sentAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	ClaimToken    string      `db:"claim_token" json:"-"`
+	ClaimedAt     null.Time   `db:"claimed_at" json:"-"`
+	Attempts      int64       `db:"attempts" json:"attempts"`
+	LastError     string      `db:"last_error" json:"lastError"`
+	Status        int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt     null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy     null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt     null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy     null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt     null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy     null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type TaskReminderParam struct {
+	ID         null.Int64 `param:"id" uri:"reminder_id" db:"id"`
+	TaskID     null.Int64 `param:"fk_task_id" uri:"task_id" db:"fk_task_id"`
+	UserID     null.Int64 `param:"fk_user_id" db:"fk_user_id"`
+	ClaimToken string     `param:"claim_token" db:"claim_token"`
+	RemindAt   null.Time  `param:"remind_at" db:"remind_at"`
+	Unsent     bool       `db:"-"` // Only the reminders which are not sent yet
+	Status     null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateTaskReminderParam struct {
+	TaskID        int64       `db:"fk_task_id" json:"-"`
+	UserID        int64       `db:"fk_user_id" json:"-"`
+	Kind          string      `db:"kind" json:"kind"`                    //Enum(before, at)
+	OffsetMinutes int64       `db:"offset_minutes" json:"offsetMinutes"` // Required by before kind, e.g. 30
+	TimeOfDay     string      `db:"time_of_day" json:"timeOfDay"`        // Required by at kind, e.g. 09:00
+	Timezone      string      `db:"timezone" json:"timezone"`            // IANA time zone of timeOfDay, e.g. Asia/Jakarta, default is UTC
+	RemindAt      null.Time   `db:"remind_at" json:"-"`
+	CreatedBy     null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy     null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+type UpdateTaskReminderParam struct {
+	RemindAt   null.Time   `param:"remind_at" db:"remind_at" json:"-"`
+	SentAt     null.Time   `param:"sent_at" db:"sent_at" json:"-"`
+	ClaimToken null.String `param:"claim_token" db:"claim_token" json:"-"`
+	Attempts   null.Int64  `param:"attempts" db:"attempts" json:"-"`
+	LastError  null.String `param:"last_error" db:"last_error" json:"-"`
+	Status     null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt  null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy  null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt  null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy  null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+// ClaimTaskReminderParam mark the due reminders as being sent by one worker
+type ClaimTaskReminderParam struct {
+	ClaimToken   string
+	Now          time.Time
+	ClaimTimeout time.Duration // Claim older than this is considered abandoned, e.g. the worker was restarted
+	MaxAttempts  int64
+	Limit        int64
+}
+
+// ScheduleAt return the time the reminder should be sent for the given due time
+func (r TaskReminder) ScheduleAt(dueTime time.Time) time.Time {
+	return ScheduleTaskReminder(r.Kind, r.OffsetMinutes, r.TimeOfDay, r.Timezone, dueTime)
+}
+
+// ScheduleTaskReminder return the time the reminder should be sent, time of day is on the due day in the
+// time zone of the user so the reminder does not depend on the time zone of the server
+func ScheduleTaskReminder(kind string, offsetMinutes int64, timeOfDay string, timezone string, dueTime time.Time) time.Time {
+	if kind == TaskReminderKindAt {
+		tod, err := time.Parse(TaskReminderTimeOfDayFormat, timeOfDay)
+		if err != nil {
+			return dueTime
+		}
+
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+
+		due := dueTime.In(loc)
+
+		return time.Date(due.Year(), due.Month(), due.Day(), tod.Hour(), tod.Minute(), 0, 0, loc)
+	}
+
+	return dueTime.Add(-time.Duration(offsetMinutes) * time.Minute)
+}
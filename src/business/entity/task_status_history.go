@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type TaskStatusHistory struct {
+	ID         int64       `db:"id" json:"id"`
+	TaskID     int64       `db:"fk_task_id" json:"taskId"`
+	FromStatus string      `db:"from_status" json:"fromStatus"` //Enum(todo, ongoing, done), empty when the task is created
+	ToStatus   string      `db:"to_status" json:"toStatus"`     //Enum(todo, ongoing, done)
+	Status     int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt  null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy  null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+}
+
+type TaskStatusHistoryParam struct {
+	ID     null.Int64 `param:"id" db:"id"`
+	TaskID null.Int64 `param:"fk_task_id" uri:"task_id" db:"fk_task_id"`
+	Status null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateTaskStatusHistoryParam struct {
+	TaskID     int64       `db:"fk_task_id"`
+	FromStatus string      `db:"from_status"`
+	ToStatus   string      `db:"to_status"`
+	CreatedBy  null.String `db:"created_by"`
+}
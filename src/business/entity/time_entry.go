@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+const (
+	// Time entry total groups
+	TimeEntryGroupByTask     = "task"
+	TimeEntryGroupByCategory = "category"
+	TimeEntryGroupByDay      = "day"
+)
+
+type TimeEntry struct {
+	ID              int64       `db:"id" json:"id"`
+	TaskID          int64       `db:"fk_task_id" json:"taskId"`
+	UserID          int64       `db:"fk_user_id" json:"userId"`
+	StartedAt       null.Time   `db:"started_at" json:"startedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	EndedAt         null.Time   `db:"ended_at" json:"endedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"` // Empty while the timer is running
+	DurationSeconds int64       `db:"duration_seconds" json:"durationSeconds"`
+	Note            string      `db:"note" json:"note"`
+	Running         bool        `db:"-" json:"running"`
+	Status          int64       `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt       null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy       null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt       null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy       null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt       null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy       null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type TimeEntryParam struct {
+	ID           null.Int64 `param:"id" uri:"time_entry_id" db:"id"`
+	TaskID       null.Int64 `param:"fk_task_id" uri:"task_id" db:"fk_task_id"`
+	UserID       null.Int64 `param:"fk_user_id" db:"fk_user_id"`
+	StartedAtGTE null.Time  `param:"started_at__gte" db:"started_at"`
+	StartedAtLT  null.Time  `param:"started_at__lt" db:"started_at"`
+	EndedAtGT    null.Time  `param:"ended_at__gt" db:"ended_at"` // The running timer has no end so it never matches
+	Running      bool       `db:"-"`                             // Only the entry which timer is still running
+	Status       null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateTimeEntryParam struct {
+	TaskID          int64       `db:"fk_task_id" json:"-"`
+	UserID          int64       `db:"fk_user_id" json:"-"`
+	StartedAt       null.Time   `db:"started_at" json:"startedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	EndedAt         null.Time   `db:"ended_at" json:"endedAt" swaggertype:"string" example:"2022-06-21T11:02:29Z"`
+	DurationSeconds int64       `db:"duration_seconds" json:"-"`
+	Note            string      `db:"note" json:"note"`
+	CreatedBy       null.String `json:"-" db:"created_by" swaggertype:"string"`
+	UpdatedBy       null.String `json:"-" db:"updated_by" swaggertype:"string"`
+}
+
+type UpdateTimeEntryParam struct {
+	EndedAt         null.Time   `param:"ended_at" db:"ended_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DurationSeconds null.Int64  `param:"duration_seconds" db:"duration_seconds" json:"-"`
+	Status          null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt       null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy       null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt       null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy       null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+type StartTimerParam struct {
+	Note string `json:"note"`
+}
+
+type TimeEntryTotalQuery struct {
+	GroupBy  string `form:"groupBy"`  //Enum(task, category, day)
+	TaskID   string `form:"taskId"`   // Only the entries of the task
+	From     string `form:"from"`     // Date (2006-01-02) or RFC 3339 time
+	To       string `form:"to"`       // Date (2006-01-02) or RFC 3339 time, the whole day is included when only the date is given
+	Timezone string `form:"timezone"` // IANA time zone of the day group, default is UTC
+}
+
+type TimeEntryTotalParam struct {
+	GroupBy  string
+	UserID   int64
+	TaskID   null.Int64
+	From     time.Time      // Inclusive
+	To       time.Time      // Exclusive
+	Now      time.Time      // The running timer is counted until this time
+	Location *time.Location // Location of the day group
+}
+
+type TimeEntryTotal struct {
+	Key             string `db:"group_key" json:"key"` // Task id, category id (0 is no category) or day
+	Name            string `db:"-" json:"name"`        // Title of the task or name of the category
+	EntryCount      int64  `db:"entry_count" json:"entryCount"`
+	DurationSeconds int64  `db:"duration_seconds" json:"durationSeconds"`
+}
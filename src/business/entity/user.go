@@ -7,19 +7,23 @@ import (
 )
 
 type User struct {
-	ID          int64       `db:"id" json:"id"`
-	RoleId      null.Int64  `db:"fk_role_id" json:"roleId"`
-	Email       string      `db:"email" json:"email"`
-	Username    string      `db:"username" json:"username"`
-	Password    string      `db:"password" json:"-"`
-	DisplayName string      `db:"display_name" json:"displayName"`
-	Status      null.Int64  `db:"status" json:"status" swaggertype:"integer"`
-	CreatedAt   null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	CreatedBy   null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
-	UpdatedAt   null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	UpdatedBy   null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
-	DeletedAt   null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	DeletedBy   null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+	ID                  int64       `db:"id" json:"id"`
+	RoleId              null.Int64  `db:"fk_role_id" json:"roleId"`
+	Email               string      `db:"email" json:"email"`
+	Username            string      `db:"username" json:"username"`
+	Password            string      `db:"password" json:"-"`
+	DisplayName         string      `db:"display_name" json:"displayName"`
+	CalendarToken       null.String `db:"calendar_token" json:"-"`
+	CalendarFeedEnabled bool        `db:"-" json:"calendarFeedEnabled"`
+	LeaderboardOptOut   bool        `db:"leaderboard_opt_out" json:"leaderboardOptOut"`
+	Level               *UserLevel  `db:"-" json:"level,omitempty"` // Only filled on the self profile
+	Status              null.Int64  `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt           null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy           null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt           null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy           null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt           null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy           null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
 }
 
 func (u *User) ConvertToAuthUser() jwtAuth.User {
@@ -31,12 +35,13 @@ func (u *User) ConvertToAuthUser() jwtAuth.User {
 }
 
 type UserParam struct {
-	ID          null.Int64  `param:"id" uri:"user_id" db:"id" form:"id"`
-	RoleId      null.Int64  `param:"fk_role_id" uri:"role_id" db:"fk_role_id" form:"fk_role_id"`
-	IDs         []int64     `param:"ids" uri:"user_ids" db:"id" form:"userIds"`
-	Email       null.String `param:"email" db:"email"`
-	Username    null.String `param:"username" db:"username"`
-	DisplayName null.String `param:"display_name" db:"display_name"`
+	ID            null.Int64  `param:"id" uri:"user_id" db:"id" form:"id"`
+	RoleId        null.Int64  `param:"fk_role_id" uri:"role_id" db:"fk_role_id" form:"fk_role_id"`
+	IDs           []int64     `param:"ids" uri:"user_ids" db:"id" form:"userIds"`
+	Email         null.String `param:"email" db:"email"`
+	Username      null.String `param:"username" db:"username"`
+	DisplayName   null.String `param:"display_name" db:"display_name"`
+	CalendarToken null.String `param:"calendar_token" db:"calendar_token"`
 	PaginationParam
 	QueryOption query.Option
 }
@@ -53,15 +58,17 @@ type CreateUserParam struct {
 }
 
 type UpdateUserParam struct {
-	RoleId      string      `param:"fk_role_id" db:"fk_role_id" json:"roleId"`
-	Username    string      `param:"username" db:"username" json:"username"`
-	DisplayName string      `param:"display_name" db:"display_name" json:"displayName"`
-	Password    string      `param:"password" db:"password" json:"-"`
-	Status      null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
-	UpdatedAt   null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	UpdatedBy   null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
-	DeletedAt   null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
-	DeletedBy   null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+	RoleId            string      `param:"fk_role_id" db:"fk_role_id" json:"roleId"`
+	Username          string      `param:"username" db:"username" json:"username"`
+	DisplayName       string      `param:"display_name" db:"display_name" json:"displayName"`
+	Password          string      `param:"password" db:"password" json:"-"`
+	CalendarToken     null.String `param:"calendar_token" db:"calendar_token" json:"-"`
+	LeaderboardOptOut null.Bool   `param:"leaderboard_opt_out" db:"leaderboard_opt_out" json:"-"`
+	Status            null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt         null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy         null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt         null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy         null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
 }
 
 type UserLoginRequest struct {
@@ -76,6 +83,11 @@ type UserLoginResponse struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
+type CalendarTokenResponse struct {
+	Token string `json:"token"` // Only shown once, regenerate the token when it is lost
+	Path  string `json:"path"`  // Path of the feed relative to the API host
+}
+
 type ChangePasswordRequest struct {
 	OldPassword     string `db:"-" json:"oldPassword"`
 	Password        string `db:"-" json:"newPassword"`
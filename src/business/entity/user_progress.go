@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// LevelXPStep is the growth of the XP needed per level, level n is reached at LevelXPStep * n * (n - 1) / 2 XP,
+// so level 2 is reached at 100 XP, level 3 at 300 XP, level 4 at 600 XP and so on
+const LevelXPStep = 100
+
+type UserLevel struct {
+	Level       int64   `json:"level"`
+	XP          int64   `json:"xp"`
+	LevelXP     int64   `json:"levelXp"`     // Total XP needed to reach the level
+	NextLevelXP int64   `json:"nextLevelXp"` // Total XP needed to reach the next level
+	Percentage  float64 `json:"percentage"`  // Progress from the level to the next one
+}
+
+// NewUserLevel compute the level reached with the XP
+func NewUserLevel(xp int64) UserLevel {
+	if xp < 0 {
+		xp = 0
+	}
+
+	level := UserLevel{Level: 1, XP: xp}
+	for levelXP(level.Level+1) <= xp {
+		level.Level++
+	}
+
+	level.LevelXP = levelXP(level.Level)
+	level.NextLevelXP = levelXP(level.Level + 1)
+	level.Percentage = float64(xp-level.LevelXP) / float64(level.NextLevelXP-level.LevelXP) * 100
+
+	return level
+}
+
+func levelXP(level int64) int64 {
+	return LevelXPStep * level * (level - 1) / 2
+}
+
+type UserProgress struct {
+	ID              int64       `db:"id" json:"-"`
+	UserID          int64       `db:"fk_user_id" json:"userId"`
+	XP              int64       `db:"xp" json:"xp"`
+	Level           UserLevel   `db:"-" json:"level"`
+	CompletedTasks  int64       `db:"completed_tasks" json:"completedTasks"`
+	CurrentStreak   int64       `db:"current_streak" json:"currentStreak"` // Zero once a day is missed
+	LongestStreak   int64       `db:"longest_streak" json:"longestStreak"`
+	LastCompletedOn null.Date   `db:"last_completed_on" json:"lastCompletedOn" swaggertype:"string" example:"2022-06-21T00:00:00Z"`
+	Status          int64       `db:"status" json:"-"`
+	CreatedAt       null.Time   `db:"created_at" json:"-"`
+	CreatedBy       null.String `db:"created_by" json:"-"`
+	UpdatedAt       null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy       null.String `db:"updated_by" json:"-"`
+}
+
+type UserProgressParam struct {
+	ID          null.Int64 `param:"id" db:"id"`
+	UserID      null.Int64 `param:"fk_user_id" db:"fk_user_id"`
+	Status      null.Int64 `param:"status" db:"status" swaggertype:"string"`
+	QueryOption query.Option
+}
+
+type CreateXPAwardParam struct {
+	UserID    int64       `db:"fk_user_id"`
+	TaskID    int64       `db:"fk_task_id"`
+	XP        int64       `db:"xp"`
+	OnTime    bool        `db:"on_time"`
+	Day       time.Time   `db:"-"` // Day of the completion in the streak time zone
+	CreatedBy null.String `db:"created_by"`
+	UpdatedBy null.String `db:"updated_by"`
+}
+
+// ProcessLevel fill the level from the XP
+func (p *UserProgress) ProcessLevel() {
+	p.Level = NewUserLevel(p.XP)
+}
+
+// ProcessStreak reset the current streak when neither today nor yesterday has a completed task,
+// the stored streak is only updated by the next completion
+func (p *UserProgress) ProcessStreak(today time.Time) {
+	if !p.LastCompletedOn.Valid {
+		p.CurrentStreak = 0
+		return
+	}
+
+	if daysBetween(p.LastCompletedOn.Time, today) > 1 {
+		p.CurrentStreak = 0
+	}
+}
+
+// AddCompletion add the XP of a completed task and extend the streak with its day
+func (p *UserProgress) AddCompletion(xp int64, day time.Time) {
+	p.XP += xp
+	p.CompletedTasks++
+
+	switch {
+	case !p.LastCompletedOn.Valid:
+		p.CurrentStreak = 1
+	case daysBetween(p.LastCompletedOn.Time, day) == 1:
+		p.CurrentStreak++
+	case daysBetween(p.LastCompletedOn.Time, day) > 1:
+		p.CurrentStreak = 1
+	default:
+		// Same day or a day before the last one, the streak does not change
+		return
+	}
+
+	if p.CurrentStreak > p.LongestStreak {
+		p.LongestStreak = p.CurrentStreak
+	}
+
+	p.LastCompletedOn = null.DateFrom(dayOf(day))
+}
+
+// dayOf return the date of t as midnight UTC, the same value a DATE column is read as
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween return the number of calendar days from the date of a to the date of b
+func daysBetween(a, b time.Time) int64 {
+	return int64(dayOf(b).Sub(dayOf(a)).Hours() / 24)
+}
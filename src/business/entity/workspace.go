@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+const (
+	// Roles of the user inside the workspace, every workspace has exactly one owner
+	WorkspaceRoleOwner  = "owner"
+	WorkspaceRoleAdmin  = "admin"
+	WorkspaceRoleMember = "member"
+
+	WorkspaceInvitationStatusPending  = "pending"
+	WorkspaceInvitationStatusAccepted = "accepted"
+	WorkspaceInvitationStatusDeclined = "declined"
+	WorkspaceInvitationStatusRevoked  = "revoked"
+)
+
+// Workspace is a team which shares its tasks and categories between its members
+type Workspace struct {
+	ID          int64       `db:"id" json:"id"`
+	OwnerID     int64       `db:"fk_owner_id" json:"ownerId"`
+	Name        string      `db:"name" json:"name"`
+	Description null.String `db:"description" json:"description" swaggertype:"string"`
+	Role        string      `db:"-" json:"role"` // Role of the user in the workspace
+	Status      null.Int64  `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt   null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy   null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt   null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt   null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type WorkspaceParam struct {
+	ID      null.Int64 `param:"id" uri:"workspace_id" db:"id"`
+	IDs     []int64    `param:"ids" db:"id"`
+	OwnerID null.Int64 `param:"fk_owner_id" db:"fk_owner_id"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateWorkspaceParam struct {
+	OwnerID     int64       `db:"fk_owner_id" json:"-"`
+	Name        string      `db:"name" json:"name"`
+	Description null.String `db:"description" json:"description" swaggertype:"string"`
+	CreatedBy   null.String `db:"created_by" json:"-" swaggertype:"string"`
+	UpdatedBy   null.String `db:"updated_by" json:"-" swaggertype:"string"`
+}
+
+type UpdateWorkspaceParam struct {
+	OwnerID     null.Int64  `param:"fk_owner_id" db:"fk_owner_id" json:"-" swaggertype:"integer"`
+	Name        string      `param:"name" db:"name" json:"name"`
+	Description null.String `param:"description" db:"description" json:"description" swaggertype:"string"`
+	Status      null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt   null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt   null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+// TransferWorkspaceParam is the member who becomes the new owner, the old owner stays as an admin
+type TransferWorkspaceParam struct {
+	UserID int64 `json:"userId"`
+}
+
+type WorkspaceMember struct {
+	ID          int64       `db:"id" json:"id"`
+	WorkspaceID int64       `db:"fk_workspace_id" json:"workspaceId"`
+	UserID      int64       `db:"fk_user_id" json:"userId"`
+	Username    string      `db:"-" json:"username"`
+	DisplayName string      `db:"-" json:"displayName"`
+	Role        string      `db:"workspace_role" json:"role"` //Enum(owner, admin, member)
+	Status      null.Int64  `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt   null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy   null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt   null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy   null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt   null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy   null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type WorkspaceMemberParam struct {
+	ID           null.Int64  `param:"id" db:"id"`
+	WorkspaceID  null.Int64  `param:"fk_workspace_id" uri:"workspace_id" db:"fk_workspace_id"`
+	WorkspaceIDs []int64     `param:"fk_workspace_ids" db:"fk_workspace_id"`
+	UserID       null.Int64  `param:"fk_user_id" uri:"user_id" db:"fk_user_id"`
+	UserIDs      []int64     `param:"fk_user_ids" db:"fk_user_id"`
+	Role         null.String `param:"workspace_role" db:"workspace_role"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateWorkspaceMemberParam struct {
+	WorkspaceID int64       `db:"fk_workspace_id"`
+	UserID      int64       `db:"fk_user_id"`
+	Role        string      `db:"workspace_role"`
+	CreatedBy   null.String `db:"created_by"`
+	UpdatedBy   null.String `db:"updated_by"`
+}
+
+type UpdateWorkspaceMemberParam struct {
+	Role      string      `param:"workspace_role" db:"workspace_role" json:"role"` //Enum(admin, member)
+	Status    null.Int64  `param:"status" db:"status" json:"-" swaggertype:"integer"`
+	UpdatedAt null.Time   `param:"updated_at" db:"updated_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy null.String `param:"updated_by" db:"updated_by" json:"-" swaggertype:"string"`
+	DeletedAt null.Time   `param:"deleted_at" db:"deleted_at" json:"-" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy null.String `param:"deleted_by" db:"deleted_by" json:"-" swaggertype:"string"`
+}
+
+type WorkspaceInvitation struct {
+	ID               int64       `db:"id" json:"id"`
+	WorkspaceID      int64       `db:"fk_workspace_id" json:"workspaceId"`
+	WorkspaceName    string      `db:"-" json:"workspaceName"`
+	InviterID        int64       `db:"fk_inviter_id" json:"inviterId"`
+	Email            string      `db:"email" json:"email"`
+	Role             string      `db:"workspace_role" json:"role"`                //Enum(admin, member)
+	InvitationStatus string      `db:"invitation_status" json:"invitationStatus"` //Enum(pending, accepted, declined, revoked)
+	RespondedAt      null.Time   `db:"responded_at" json:"respondedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	Status           null.Int64  `db:"status" json:"status" swaggertype:"integer"`
+	CreatedAt        null.Time   `db:"created_at" json:"createdAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	CreatedBy        null.String `db:"created_by" json:"createdBy" swaggertype:"string"`
+	UpdatedAt        null.Time   `db:"updated_at" json:"updatedAt" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	UpdatedBy        null.String `db:"updated_by" json:"updatedBy" swaggertype:"string"`
+	DeletedAt        null.Time   `db:"deleted_at" json:"deletedAt,omitempty" swaggertype:"string" example:"2022-06-21T10:32:29Z"`
+	DeletedBy        null.String `db:"deleted_by" json:"deletedBy,omitempty" swaggertype:"string"`
+}
+
+type WorkspaceInvitationParam struct {
+	ID               null.Int64  `param:"id" uri:"invitation_id" db:"id"`
+	WorkspaceID      null.Int64  `param:"fk_workspace_id" uri:"workspace_id" db:"fk_workspace_id"`
+	Email            null.String `param:"email" db:"email"`
+	InvitationStatus null.String `param:"invitation_status" db:"invitation_status" form:"invitationStatus"`
+	PaginationParam
+	QueryOption query.Option
+}
+
+type CreateWorkspaceInvitationParam struct {
+	WorkspaceID int64       `db:"fk_workspace_id" json:"-"`
+	InviterID   int64       `db:"fk_inviter_id" json:"-"`
+	Email       string      `db:"email" json:"email"`
+	Role        string      `db:"workspace_role" json:"role"` //Enum(admin, member), default is member
+	CreatedBy   null.String `db:"created_by" json:"-" swaggertype:"string"`
+	UpdatedBy   null.String `db:"updated_by" json:"-" swaggertype:"string"`
+}
+
+type UpdateWorkspaceInvitationParam struct {
+	InvitationStatus string      `param:"invitation_status" db:"invitation_status"`
+	RespondedAt      null.Time   `param:"responded_at" db:"responded_at"`
+	Status           null.Int64  `param:"status" db:"status"`
+	UpdatedAt        null.Time   `param:"updated_at" db:"updated_at"`
+	UpdatedBy        null.String `param:"updated_by" db:"updated_by"`
+	DeletedAt        null.Time   `param:"deleted_at" db:"deleted_at"`
+	DeletedBy        null.String `param:"deleted_by" db:"deleted_by"`
+}
+
+// IsWorkspaceManager return true when the role can manage the members, the invitations and the shared categories
+func IsWorkspaceManager(role string) bool {
+	return role == WorkspaceRoleOwner || role == WorkspaceRoleAdmin
+}
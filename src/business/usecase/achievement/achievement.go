@@ -0,0 +1,393 @@
+package achievement
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	achievementDom "github.com/adiatma85/gg-project/src/business/domain/achievement"
+	focusSessionDom "github.com/adiatma85/gg-project/src/business/domain/focussession"
+	taskDom "github.com/adiatma85/gg-project/src/business/domain/task"
+	userAchievementDom "github.com/adiatma85/gg-project/src/business/domain/userachievement"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	progressUc "github.com/adiatma85/gg-project/src/business/usecase/progress"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type Interface interface {
+	GetList(ctx context.Context, params entity.AchievementParam) ([]entity.Achievement, error)
+	Evaluate(ctx context.Context, event entity.AchievementEvent) ([]entity.Achievement, error)
+	Create(ctx context.Context, req entity.CreateAchievementParam) (entity.Achievement, error)
+	Update(ctx context.Context, updateParam entity.UpdateAchievementParam, selectParam entity.AchievementParam) error
+	Delete(ctx context.Context, selectParam entity.AchievementParam) error
+}
+
+type InitParam struct {
+	Log             log.Interface
+	Achievement     achievementDom.Interface
+	UserAchievement userAchievementDom.Interface
+	FocusSession    focusSessionDom.Interface
+	TaskDom         taskDom.Interface
+	Progress        progressUc.Interface
+	JwtAuth         jwtAuth.Interface
+}
+
+type achievement struct {
+	log             log.Interface
+	achievement     achievementDom.Interface
+	userAchievement userAchievementDom.Interface
+	focusSession    focusSessionDom.Interface
+	taskDom         taskDom.Interface
+	progress        progressUc.Interface
+	jwtAuth         jwtAuth.Interface
+}
+
+var Now = time.Now
+
+var codePattern = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+func Init(param InitParam) Interface {
+	a := &achievement{
+		log:             param.Log,
+		achievement:     param.Achievement,
+		userAchievement: param.UserAchievement,
+		focusSession:    param.FocusSession,
+		taskDom:         param.TaskDom,
+		progress:        param.Progress,
+		jwtAuth:         param.JwtAuth,
+	}
+
+	return a
+}
+
+// GetList return every active achievement with whether the user has unlocked it
+func (a *achievement) GetList(ctx context.Context, params entity.AchievementParam) ([]entity.Achievement, error) {
+	user, err := a.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return []entity.Achievement{}, err
+	}
+
+	var unlockedFilter null.Bool
+	if params.Unlocked != "" {
+		value, err := strconv.ParseBool(params.Unlocked)
+		if err != nil {
+			return []entity.Achievement{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid unlocked %q, must be true or false", params.Unlocked))
+		}
+		unlockedFilter = null.BoolFrom(value)
+	}
+
+	unlocked, err := a.getUnlocked(ctx, user.User.ID)
+	if err != nil {
+		return []entity.Achievement{}, err
+	}
+
+	// The achievements are few, so the whole list is returned at once
+	params.QueryOption.IsActive = true
+	params.QueryOption.DisableLimit = true
+	params.SortBy = []string{"id"}
+
+	achievements, _, err := a.achievement.GetList(ctx, params)
+	if err != nil {
+		return []entity.Achievement{}, err
+	}
+
+	result := []entity.Achievement{}
+	for _, item := range achievements {
+		if unlockedAt, ok := unlocked[item.ID]; ok {
+			item.Unlocked = true
+			item.UnlockedAt = unlockedAt
+		}
+
+		if unlockedFilter.Valid && unlockedFilter.Bool != item.Unlocked {
+			continue
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// getUnlocked return the unlock time of the achievements of the user by the achievement id
+func (a *achievement) getUnlocked(ctx context.Context, userID int64) (map[int64]null.Time, error) {
+	result := map[int64]null.Time{}
+
+	userAchievements, _, err := a.userAchievement.GetList(ctx, entity.UserAchievementParam{
+		UserID: null.Int64From(userID),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, ua := range userAchievements {
+		result[ua.AchievementID] = ua.UnlockedAt
+	}
+
+	return result, nil
+}
+
+// achievementMetrics hold the metrics of the user which are read once per evaluation, only when a rule needs them
+type achievementMetrics struct {
+	progress     *entity.UserProgress
+	focus        *entity.FocusTotal
+	overdueCount *int64
+}
+
+// Evaluate unlock the achievements which rules are affected by the event and reached by the user,
+// the newly unlocked achievements are returned
+func (a *achievement) Evaluate(ctx context.Context, event entity.AchievementEvent) ([]entity.Achievement, error) {
+	result := []entity.Achievement{}
+
+	rules := []string{}
+	for rule, eventType := range entity.AchievementRuleEvents {
+		if eventType == event.Type {
+			rules = append(rules, rule)
+		}
+	}
+
+	if len(rules) == 0 {
+		return result, nil
+	}
+
+	achievements, _, err := a.achievement.GetList(ctx, entity.AchievementParam{
+		Rules: rules,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil || len(achievements) == 0 {
+		return result, err
+	}
+
+	unlocked, err := a.getUnlocked(ctx, event.UserID)
+	if err != nil {
+		return result, err
+	}
+
+	metrics := achievementMetrics{progress: event.Progress}
+	for _, item := range achievements {
+		if _, ok := unlocked[item.ID]; ok {
+			continue
+		}
+
+		isReached, err := a.isReached(ctx, item, event, &metrics)
+		if err != nil {
+			return result, err
+		} else if !isReached {
+			continue
+		}
+
+		now := Now()
+		isCreated, err := a.userAchievement.Create(ctx, entity.CreateUserAchievementParam{
+			UserID:        event.UserID,
+			AchievementID: item.ID,
+			UnlockedAt:    null.TimeFrom(now),
+			CreatedBy:     null.StringFrom(fmt.Sprintf("%v", event.UserID)),
+			UpdatedBy:     null.StringFrom(fmt.Sprintf("%v", event.UserID)),
+		})
+		if err != nil {
+			return result, err
+		} else if !isCreated {
+			continue
+		}
+
+		a.log.Info(ctx, fmt.Sprintf("user %d unlocked achievement %s", event.UserID, item.Code))
+
+		item.Unlocked = true
+		item.UnlockedAt = null.TimeFrom(now)
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// isReached check the rule of the achievement against the metric of the user
+func (a *achievement) isReached(ctx context.Context, item entity.Achievement, event entity.AchievementEvent, metrics *achievementMetrics) (bool, error) {
+	switch item.Rule {
+	case entity.AchievementRuleTasksDone, entity.AchievementRuleStreakDays, entity.AchievementRuleLevel, entity.AchievementRuleXP:
+		if metrics.progress == nil {
+			progress, err := a.progress.Get(ctx, event.UserID)
+			if err != nil {
+				return false, err
+			}
+			metrics.progress = &progress
+		}
+
+		switch item.Rule {
+		case entity.AchievementRuleTasksDone:
+			return metrics.progress.CompletedTasks >= item.Threshold, nil
+		case entity.AchievementRuleStreakDays:
+			return metrics.progress.CurrentStreak >= item.Threshold, nil
+		case entity.AchievementRuleLevel:
+			return metrics.progress.Level.Level >= item.Threshold, nil
+		default:
+			return metrics.progress.XP >= item.Threshold, nil
+		}
+	case entity.AchievementRuleFocusSessions, entity.AchievementRuleFocusMinutes:
+		if metrics.focus == nil {
+			total, err := a.focusSession.GetTotal(ctx, event.UserID)
+			if err != nil {
+				return false, err
+			}
+			metrics.focus = &total
+		}
+
+		if item.Rule == entity.AchievementRuleFocusSessions {
+			return metrics.focus.Completed >= item.Threshold, nil
+		}
+		return metrics.focus.FocusSeconds/60 >= item.Threshold, nil
+	case entity.AchievementRuleOverdueCleared:
+		// Only finishing an overdue task can clear them, having no overdue task at all is not an achievement
+		if event.Task == nil || !event.Task.DueTime.Valid || !event.Task.DueTime.Time.Before(Now()) {
+			return false, nil
+		}
+
+		if metrics.overdueCount == nil {
+			count, err := a.countOverdue(ctx, event.UserID)
+			if err != nil {
+				return false, err
+			}
+			metrics.overdueCount = &count
+		}
+
+		return *metrics.overdueCount == 0, nil
+	}
+
+	return false, nil
+}
+
+// countOverdue return the number of the unfinished tasks of the user which are past their due time
+func (a *achievement) countOverdue(ctx context.Context, userID int64) (int64, error) {
+	params := entity.TaskParam{
+		UserId:       null.Int64From(userID),
+		DueTimeLT:    null.TimeFrom(Now()),
+		TaskStatusNE: entity.TaskStatusDone,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+	params.Limit = 1
+	params.IncludePagination = true
+
+	tasks, pg, err := a.taskDom.GetList(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+
+	return pg.TotalElements, nil
+}
+
+func validateRule(rule string, threshold int64) error {
+	if _, ok := entity.AchievementRuleEvents[rule]; !ok {
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid rule %q, must be one of tasks_done, streak_days, level, xp, overdue_cleared, focus_sessions, focus_minutes", rule))
+	}
+
+	if threshold < 1 {
+		return errors.NewWithCode(codes.CodeBadRequest, "threshold must be greater than zero")
+	}
+
+	return nil
+}
+
+func (a *achievement) Create(ctx context.Context, req entity.CreateAchievementParam) (entity.Achievement, error) {
+	user, err := a.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Achievement{}, err
+	}
+
+	req.Code = strings.ToLower(strings.TrimSpace(req.Code))
+	if !codePattern.MatchString(req.Code) {
+		return entity.Achievement{}, errors.NewWithCode(codes.CodeBadRequest, "code must be 1 to 64 lowercase letters, digits or underscores")
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return entity.Achievement{}, errors.NewWithCode(codes.CodeBadRequest, "name is required")
+	}
+
+	// Threshold is not used by the overdue rule
+	if req.Rule == entity.AchievementRuleOverdueCleared && req.Threshold == 0 {
+		req.Threshold = 1
+	}
+
+	if err := validateRule(req.Rule, req.Threshold); err != nil {
+		return entity.Achievement{}, err
+	}
+
+	// The code is unique among the deleted achievements too
+	_, err = a.achievement.Get(ctx, entity.AchievementParam{Code: null.StringFrom(req.Code)})
+	if err == nil {
+		return entity.Achievement{}, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("achievement %s already exists", req.Code))
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return entity.Achievement{}, err
+	}
+
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return a.achievement.Create(ctx, req)
+}
+
+// Update change the achievement, the users who already reached the new rule unlock it on their next event
+func (a *achievement) Update(ctx context.Context, updateParam entity.UpdateAchievementParam, selectParam entity.AchievementParam) error {
+	user, err := a.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	selectParam.QueryOption.IsActive = true
+	current, err := a.achievement.Get(ctx, selectParam)
+	if err != nil {
+		return err
+	}
+
+	rule, threshold := current.Rule, current.Threshold
+	if updateParam.Rule != "" {
+		rule = updateParam.Rule
+	}
+	if updateParam.Threshold != 0 {
+		threshold = updateParam.Threshold
+	}
+
+	if err := validateRule(rule, threshold); err != nil {
+		return err
+	}
+
+	updateParam.Name = strings.TrimSpace(updateParam.Name)
+	updateParam.UpdatedAt = null.TimeFrom(Now())
+	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return a.achievement.Update(ctx, updateParam, entity.AchievementParam{ID: null.Int64From(current.ID)})
+}
+
+func (a *achievement) Delete(ctx context.Context, selectParam entity.AchievementParam) error {
+	user, err := a.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	deleteParam := entity.UpdateAchievementParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return a.achievement.Update(ctx, deleteParam, selectParam)
+}
@@ -0,0 +1,251 @@
+package attachment
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	attachmentDom "github.com/adiatma85/gg-project/src/business/domain/attachment"
+	storageDom "github.com/adiatma85/gg-project/src/business/domain/storage"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+)
+
+type Interface interface {
+	Create(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTaskAttachmentParam) (entity.TaskAttachment, error)
+	GetList(ctx context.Context, params entity.TaskAttachmentParam) ([]entity.TaskAttachment, *entity.Pagination, error)
+	Open(ctx context.Context, params entity.TaskAttachmentParam) (entity.TaskAttachmentFile, error)
+	Delete(ctx context.Context, selectParam entity.TaskAttachmentParam) error
+}
+
+type Config struct {
+	MaxFileSize int64 // Maximum size of a single file in bytes
+}
+
+type InitParam struct {
+	Log        log.Interface
+	Attachment attachmentDom.Interface
+	Storage    storageDom.Interface
+	Task       taskUc.Interface
+	JwtAuth    jwtAuth.Interface
+	Conf       Config
+}
+
+type attachment struct {
+	log        log.Interface
+	attachment attachmentDom.Interface
+	storage    storageDom.Interface
+	task       taskUc.Interface
+	jwtAuth    jwtAuth.Interface
+	conf       Config
+}
+
+var Now = time.Now
+
+// Default maximum size of a single file, 10 MB
+const defaultMaxFileSize = 10 << 20
+
+// Number of bytes read to detect the MIME type, the same as the mimetype library default
+const mimeHeaderSize = 3072
+
+func Init(param InitParam) Interface {
+	a := &attachment{
+		log:        param.Log,
+		attachment: param.Attachment,
+		storage:    param.Storage,
+		task:       param.Task,
+		jwtAuth:    param.JwtAuth,
+		conf:       param.Conf,
+	}
+
+	if a.conf.MaxFileSize < 1 {
+		a.conf.MaxFileSize = defaultMaxFileSize
+	}
+
+	return a
+}
+
+func (a *attachment) Create(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTaskAttachmentParam) (entity.TaskAttachment, error) {
+	user, err := a.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TaskAttachment{}, err
+	}
+
+	if req.Content == nil {
+		return entity.TaskAttachment{}, errors.NewWithCode(codes.CodeBadRequest, "file is required")
+	}
+
+	if req.Size > a.conf.MaxFileSize {
+		return entity.TaskAttachment{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("file size must not exceed %d bytes", a.conf.MaxFileSize))
+	}
+
+	// Make sure the task is accessible by the user
+	task, err := a.task.Get(ctx, entity.TaskParam{ID: taskParam.ID})
+	if err != nil {
+		return entity.TaskAttachment{}, err
+	}
+
+	// Detect the MIME type from the content instead of trusting the client
+	content := bufio.NewReaderSize(req.Content, mimeHeaderSize)
+	head, err := content.Peek(mimeHeaderSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return entity.TaskAttachment{}, errors.NewWithCode(codes.CodeBadRequest, err.Error())
+	}
+
+	// The checksum and the size are computed while the file is streamed into the storage
+	hash := sha256.New()
+	counter := &countingReader{r: io.LimitReader(content, a.conf.MaxFileSize+1)}
+	storageKey := path.Join("task", fmt.Sprintf("%d", task.ID), uuid.New().String())
+
+	if err := a.storage.Put(ctx, storageKey, io.TeeReader(counter, hash)); err != nil {
+		return entity.TaskAttachment{}, err
+	}
+
+	if counter.n > a.conf.MaxFileSize {
+		if err := a.storage.Delete(ctx, storageKey); err != nil {
+			a.log.Error(ctx, err)
+		}
+		return entity.TaskAttachment{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("file size must not exceed %d bytes", a.conf.MaxFileSize))
+	}
+
+	req.TaskID = task.ID
+	req.UserID = user.User.ID
+	req.FileName = sanitizeFileName(req.FileName)
+	req.MimeType = mimetype.Detect(head).String()
+	req.Size = counter.n
+	req.Checksum = hex.EncodeToString(hash.Sum(nil))
+	req.StorageKey = storageKey
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	result, err := a.attachment.Create(ctx, req)
+	if err != nil {
+		// Do not keep the orphan file when the metadata can not be saved
+		if err := a.storage.Delete(ctx, storageKey); err != nil {
+			a.log.Error(ctx, err)
+		}
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (a *attachment) GetList(ctx context.Context, params entity.TaskAttachmentParam) ([]entity.TaskAttachment, *entity.Pagination, error) {
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	// Make sure the task is accessible by the user
+	task, err := a.task.Get(ctx, entity.TaskParam{ID: params.TaskID})
+	if err != nil {
+		return []entity.TaskAttachment{}, &entity.Pagination{}, err
+	}
+
+	params.TaskID = null.Int64From(task.ID)
+
+	return a.attachment.GetList(ctx, params)
+}
+
+func (a *attachment) Open(ctx context.Context, params entity.TaskAttachmentParam) (entity.TaskAttachmentFile, error) {
+	attachment, err := a.getAttachment(ctx, params)
+	if err != nil {
+		return entity.TaskAttachmentFile{}, err
+	}
+
+	content, err := a.storage.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return entity.TaskAttachmentFile{}, err
+	}
+
+	return entity.TaskAttachmentFile{
+		TaskAttachment: attachment,
+		Content:        content,
+	}, nil
+}
+
+func (a *attachment) Delete(ctx context.Context, selectParam entity.TaskAttachmentParam) error {
+	user, err := a.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	task, err := a.task.Get(ctx, entity.TaskParam{ID: selectParam.TaskID})
+	if err != nil {
+		return err
+	}
+
+	attachment, err := a.attachment.Get(ctx, entity.TaskAttachmentParam{
+		ID:     selectParam.ID,
+		TaskID: null.Int64From(task.ID),
+		Status: null.Int64From(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only the uploader and the managers of the task can delete the attachment
+	if attachment.UserID != user.User.ID {
+		if canManage, err := a.task.CanManage(ctx, task); err != nil {
+			return err
+		} else if !canManage {
+			return errors.NewWithCode(codes.CodeForbidden, "only the uploader or the owner can delete the attachment")
+		}
+	}
+
+	// The file is kept in the storage so the soft deleted attachment can still be restored
+	deleteParam := entity.UpdateTaskAttachmentParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return a.attachment.Update(ctx, deleteParam, entity.TaskAttachmentParam{ID: null.Int64From(attachment.ID)})
+}
+
+// getAttachment return the active attachment of the task which is accessible by the user
+func (a *attachment) getAttachment(ctx context.Context, params entity.TaskAttachmentParam) (entity.TaskAttachment, error) {
+	task, err := a.task.Get(ctx, entity.TaskParam{ID: params.TaskID})
+	if err != nil {
+		return entity.TaskAttachment{}, err
+	}
+
+	return a.attachment.Get(ctx, entity.TaskAttachmentParam{
+		ID:     params.ID,
+		TaskID: null.Int64From(task.ID),
+		Status: null.Int64From(1),
+	})
+}
+
+// sanitizeFileName strip the directory part of the uploaded file name
+func sanitizeFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == "" {
+		return "file"
+	}
+
+	return name
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,725 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	boardDom "github.com/adiatma85/gg-project/src/business/domain/board"
+	boardColumnDom "github.com/adiatma85/gg-project/src/business/domain/boardcolumn"
+	boardTaskDom "github.com/adiatma85/gg-project/src/business/domain/boardtask"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	workspaceUc "github.com/adiatma85/gg-project/src/business/usecase/workspace"
+	"github.com/adiatma85/gg-project/utils/rank"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+var Now = time.Now
+
+type Interface interface {
+	Create(ctx context.Context, req entity.CreateBoardParam) (entity.Board, error)
+	Get(ctx context.Context, params entity.BoardParam) (entity.Board, error)
+	GetList(ctx context.Context, params entity.BoardParam) ([]entity.Board, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateBoardParam, selectParam entity.BoardParam) error
+	Delete(ctx context.Context, selectParam entity.BoardParam) error
+	CreateColumn(ctx context.Context, selectParam entity.BoardParam, req entity.CreateBoardColumnParam) (entity.BoardColumn, error)
+	UpdateColumn(ctx context.Context, updateParam entity.UpdateBoardColumnParam, selectParam entity.BoardColumnParam) (entity.BoardColumn, error)
+	DeleteColumn(ctx context.Context, selectParam entity.BoardColumnParam) error
+	MoveTask(ctx context.Context, selectParam entity.BoardTaskParam, req entity.MoveBoardTaskParam) (entity.Board, error)
+}
+
+type InitParam struct {
+	Log         log.Interface
+	Board       boardDom.Interface
+	BoardColumn boardColumnDom.Interface
+	BoardTask   boardTaskDom.Interface
+	Task        taskUc.Interface
+	Workspace   workspaceUc.Interface
+	JwtAuth     jwtAuth.Interface
+}
+
+type board struct {
+	log         log.Interface
+	board       boardDom.Interface
+	boardColumn boardColumnDom.Interface
+	boardTask   boardTaskDom.Interface
+	task        taskUc.Interface
+	workspace   workspaceUc.Interface
+	jwtAuth     jwtAuth.Interface
+}
+
+func Init(param InitParam) Interface {
+	b := &board{
+		log:         param.Log,
+		board:       param.Board,
+		boardColumn: param.BoardColumn,
+		boardTask:   param.BoardTask,
+		task:        param.Task,
+		workspace:   param.Workspace,
+		jwtAuth:     param.JwtAuth,
+	}
+
+	return b
+}
+
+// Create the board with its columns, the board of a workspace can be created by every member
+func (b *board) Create(ctx context.Context, req entity.CreateBoardParam) (entity.Board, error) {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Board{}, err
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return entity.Board{}, errors.NewWithCode(codes.CodeBadRequest, "name is required")
+	}
+
+	if req.WorkspaceID.Valid {
+		if _, err := b.workspace.Authorize(ctx, req.WorkspaceID.Int64, user.User.ID); err != nil {
+			return entity.Board{}, err
+		}
+	}
+
+	if len(req.Columns) == 0 {
+		req.Columns = defaultColumns()
+	}
+
+	statuses := map[string]bool{}
+	for i := range req.Columns {
+		if err := validateColumn(&req.Columns[i], statuses); err != nil {
+			return entity.Board{}, err
+		}
+
+		if !req.Columns[i].ColumnOrder.Valid {
+			req.Columns[i].ColumnOrder = null.Int64From(int64(i))
+		}
+		req.Columns[i].CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+		req.Columns[i].UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	}
+
+	req.UserID = user.User.ID
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	board, err := b.board.Create(ctx, req)
+	if err != nil {
+		return board, err
+	}
+
+	return b.Get(ctx, entity.BoardParam{ID: null.Int64From(board.ID)})
+}
+
+// Get the board with its columns, every column has its tasks in their position on the board
+func (b *board) Get(ctx context.Context, params entity.BoardParam) (entity.Board, error) {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Board{}, err
+	}
+
+	board, err := b.getBoard(ctx, params.ID.Int64, user.User.ID)
+	if err != nil {
+		return board, err
+	}
+
+	columns, err := b.getColumns(ctx, board.ID)
+	if err != nil {
+		return board, err
+	}
+
+	board.Columns, err = b.groupTasks(ctx, board, columns)
+	if err != nil {
+		return board, err
+	}
+
+	return board, nil
+}
+
+// GetList return the personal boards of the user and the boards of the workspaces of the user
+func (b *board) GetList(ctx context.Context, params entity.BoardParam) ([]entity.Board, *entity.Pagination, error) {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := b.applyAccessFilter(ctx, &params, user.User.ID); err != nil {
+		return nil, nil, err
+	}
+
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	return b.board.GetList(ctx, params)
+}
+
+func (b *board) Update(ctx context.Context, updateParam entity.UpdateBoardParam, selectParam entity.BoardParam) error {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	board, err := b.getManagedBoard(ctx, selectParam.ID.Int64, user.User.ID)
+	if err != nil {
+		return err
+	}
+
+	updateParam.Name = strings.TrimSpace(updateParam.Name)
+	updateParam.UpdatedAt = null.TimeFrom(Now())
+	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return b.board.Update(ctx, updateParam, entity.BoardParam{ID: null.Int64From(board.ID)})
+}
+
+// Delete the board with its columns, the tasks are left as is
+func (b *board) Delete(ctx context.Context, selectParam entity.BoardParam) error {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	board, err := b.getManagedBoard(ctx, selectParam.ID.Int64, user.User.ID)
+	if err != nil {
+		return err
+	}
+
+	deletedAt := null.TimeFrom(Now())
+	deletedBy := null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	if err := b.boardColumn.Update(ctx, entity.UpdateBoardColumnParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: deletedAt,
+		DeletedBy: deletedBy,
+	}, entity.BoardColumnParam{
+		BoardID: null.Int64From(board.ID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}); err != nil {
+		return err
+	}
+
+	return b.board.Update(ctx, entity.UpdateBoardParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: deletedAt,
+		DeletedBy: deletedBy,
+	}, entity.BoardParam{ID: null.Int64From(board.ID)})
+}
+
+// CreateColumn add the column to the board, a task status can only have one column on the board
+func (b *board) CreateColumn(ctx context.Context, selectParam entity.BoardParam, req entity.CreateBoardColumnParam) (entity.BoardColumn, error) {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.BoardColumn{}, err
+	}
+
+	board, err := b.getManagedBoard(ctx, selectParam.ID.Int64, user.User.ID)
+	if err != nil {
+		return entity.BoardColumn{}, err
+	}
+
+	columns, err := b.getColumns(ctx, board.ID)
+	if err != nil {
+		return entity.BoardColumn{}, err
+	}
+
+	statuses := map[string]bool{}
+	for _, column := range columns {
+		if column.ColumnType == entity.BoardColumnTypeStatus {
+			statuses[column.TaskStatus.String] = true
+		}
+	}
+
+	if err := validateColumn(&req, statuses); err != nil {
+		return entity.BoardColumn{}, err
+	}
+
+	if !req.ColumnOrder.Valid {
+		req.ColumnOrder = null.Int64From(0)
+		if len(columns) > 0 {
+			req.ColumnOrder = null.Int64From(columns[len(columns)-1].ColumnOrder + 1)
+		}
+	}
+
+	req.BoardID = board.ID
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	column, err := b.boardColumn.Create(ctx, req)
+	if err != nil {
+		return column, err
+	}
+
+	column.Tasks = []entity.Task{}
+
+	return column, nil
+}
+
+// UpdateColumn rename or reorder the column, the type and the status of the column can not be changed
+func (b *board) UpdateColumn(ctx context.Context, updateParam entity.UpdateBoardColumnParam, selectParam entity.BoardColumnParam) (entity.BoardColumn, error) {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.BoardColumn{}, err
+	}
+
+	column, err := b.getManagedColumn(ctx, selectParam, user.User.ID)
+	if err != nil {
+		return column, err
+	}
+
+	updateParam.Name = strings.TrimSpace(updateParam.Name)
+	updateParam.UpdatedAt = null.TimeFrom(Now())
+	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	if err := b.boardColumn.Update(ctx, updateParam, entity.BoardColumnParam{ID: null.Int64From(column.ID)}); err != nil {
+		return column, err
+	}
+
+	column, err = b.boardColumn.Get(ctx, entity.BoardColumnParam{ID: null.Int64From(column.ID)})
+	if err != nil {
+		return column, err
+	}
+
+	column.Tasks = []entity.Task{}
+
+	return column, nil
+}
+
+// DeleteColumn remove the column from the board, its tasks go back to the column of their status
+func (b *board) DeleteColumn(ctx context.Context, selectParam entity.BoardColumnParam) error {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	column, err := b.getManagedColumn(ctx, selectParam, user.User.ID)
+	if err != nil {
+		return err
+	}
+
+	columns, err := b.getColumns(ctx, column.BoardID)
+	if err != nil {
+		return err
+	}
+
+	if len(columns) < 2 {
+		return errors.NewWithCode(codes.CodeBadRequest, "the last column of the board can not be deleted")
+	}
+
+	return b.boardColumn.Update(ctx, entity.UpdateBoardColumnParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}, entity.BoardColumnParam{ID: null.Int64From(column.ID)})
+}
+
+// MoveTask put the task on the column at the position. Moving the task to a status column changes the status of the
+// task so the task rules, e.g. the blockers, can reject the move, and the task is placed in the same transaction. The
+// moved task gets a rank key between its new neighbors, so only its place is written
+func (b *board) MoveTask(ctx context.Context, selectParam entity.BoardTaskParam, req entity.MoveBoardTaskParam) (entity.Board, error) {
+	user, err := b.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Board{}, err
+	}
+
+	if req.Position < 0 {
+		return entity.Board{}, errors.NewWithCode(codes.CodeBadRequest, "position must not be negative")
+	}
+
+	board, err := b.getBoard(ctx, selectParam.BoardID.Int64, user.User.ID)
+	if err != nil {
+		return board, err
+	}
+
+	columns, err := b.getColumns(ctx, board.ID)
+	if err != nil {
+		return board, err
+	}
+
+	target := -1
+	for i, column := range columns {
+		if column.ID == req.ColumnID {
+			target = i
+			break
+		}
+	}
+	if target < 0 {
+		return board, errors.NewWithCode(codes.CodeNotFound, fmt.Sprintf("column %d is not on the board", req.ColumnID))
+	}
+
+	task, err := b.task.Get(ctx, entity.TaskParam{ID: selectParam.TaskID})
+	if err != nil {
+		return board, err
+	}
+
+	if !isOnBoard(board, task) {
+		return board, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("task %d is not on the board", task.ID))
+	}
+
+	tasks, err := b.getTasks(ctx, board)
+	if err != nil {
+		return board, err
+	}
+
+	column := columns[target]
+	isStatusChange := column.ColumnType == entity.BoardColumnTypeStatus && column.TaskStatus.String != task.TaskStatus
+	if isStatusChange {
+		for i := range tasks {
+			if tasks[i].ID == task.ID {
+				tasks[i].TaskStatus = column.TaskStatus.String
+			}
+		}
+	}
+
+	// The neighbors are read after the board is locked, so the concurrent moves on the board do not pick the same gap
+	place := func(boardTasks []entity.BoardTask) ([]entity.CreateBoardTaskParam, error) {
+		grouped := placeTasks(columns, tasks, boardTasks)
+		return b.getPlaces(ctx, board.ID, column.ID, grouped[target], task.ID, int(req.Position), user.User.ID)
+	}
+
+	if isStatusChange {
+		_, err = b.task.ChangeStatus(ctx, entity.TaskParam{ID: null.Int64From(task.ID)}, column.TaskStatus.String, func(tx sql.CommandTx) (sql.CommandTx, error) {
+			return b.boardTask.MoveTx(tx, board.ID, column.ID, place)
+		})
+	} else {
+		err = b.boardTask.Move(ctx, board.ID, column.ID, place)
+	}
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return board, errors.NewWithCode(codes.CodeNotFound, fmt.Sprintf("column %d is not on the board", req.ColumnID))
+	} else if err != nil {
+		return board, err
+	}
+
+	board.Columns, err = b.groupTasks(ctx, board, columns)
+	if err != nil {
+		return board, err
+	}
+
+	return board, nil
+}
+
+// getPlaces return the place of the moved task between the tasks at the position and before it. When the keys between
+// the neighbors are used up, every task of the column is placed again with short keys in the same order
+func (b *board) getPlaces(ctx context.Context, boardID, columnID int64, columnTasks []columnTask, taskID int64, position int, userID int64) ([]entity.CreateBoardTaskParam, error) {
+	others := []columnTask{}
+	for _, columnTask := range columnTasks {
+		if columnTask.task.ID != taskID {
+			others = append(others, columnTask)
+		}
+	}
+
+	if position > len(others) {
+		position = len(others)
+	}
+
+	var lower, upper string
+	if position > 0 {
+		lower = others[position-1].position
+	}
+	if position < len(others) {
+		upper = others[position].position
+	}
+
+	newPlace := func(taskID int64, position string) entity.CreateBoardTaskParam {
+		return entity.CreateBoardTaskParam{
+			BoardID:   boardID,
+			ColumnID:  columnID,
+			TaskID:    taskID,
+			Position:  position,
+			CreatedBy: null.StringFrom(fmt.Sprintf("%v", userID)),
+			UpdatedBy: null.StringFrom(fmt.Sprintf("%v", userID)),
+		}
+	}
+
+	// The neighbor without a key, e.g. the task created before the tasks were ranked, leaves no gap to place into
+	hasKeys := (position == 0 || lower != "") && (position == len(others) || upper != "")
+	key, err := rank.Between(lower, upper)
+	if err == nil && hasKeys && len(key) <= rank.RebalanceLength {
+		return []entity.CreateBoardTaskParam{newPlace(taskID, key)}, nil
+	}
+
+	taskIDs := []int64{}
+	for _, columnTask := range others {
+		taskIDs = append(taskIDs, columnTask.task.ID)
+	}
+	taskIDs = append(taskIDs[:position], append([]int64{taskID}, taskIDs[position:]...)...)
+
+	keys, err := rank.Sequence(len(taskIDs))
+	if err != nil {
+		return nil, errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	b.log.Info(ctx, fmt.Sprintf("rebalance the position of %d tasks in column %d", len(taskIDs), columnID))
+
+	places := []entity.CreateBoardTaskParam{}
+	for i, id := range taskIDs {
+		places = append(places, newPlace(id, keys[i]))
+	}
+
+	return places, nil
+}
+
+// applyAccessFilter restrict the boards to the ones owned by the user and the ones of the workspaces of the user,
+// admin can access every board
+func (b *board) applyAccessFilter(ctx context.Context, params *entity.BoardParam, userID int64) error {
+	if entity.IsSuperAdmin(ctx, userID) {
+		return nil
+	}
+
+	workspaceIDs, err := b.workspace.GetWorkspaceIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	params.AccessUserID = null.Int64From(userID)
+	params.AccessWorkspaceIDs = workspaceIDs
+
+	return nil
+}
+
+func (b *board) getBoard(ctx context.Context, boardID, userID int64) (entity.Board, error) {
+	params := entity.BoardParam{
+		ID: null.Int64From(boardID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+
+	if err := b.applyAccessFilter(ctx, &params, userID); err != nil {
+		return entity.Board{}, err
+	}
+
+	return b.board.Get(ctx, params)
+}
+
+// getManagedBoard return the board which can be changed by the user, that is the owner of the board or the owner and
+// the admins of its workspace
+func (b *board) getManagedBoard(ctx context.Context, boardID, userID int64) (entity.Board, error) {
+	board, err := b.getBoard(ctx, boardID, userID)
+	if err != nil {
+		return board, err
+	}
+
+	if board.UserID == userID || entity.IsSuperAdmin(ctx, userID) {
+		return board, nil
+	}
+
+	if !board.WorkspaceID.Valid {
+		return board, errors.NewWithCode(codes.CodeForbidden, "only the owner can change the board")
+	}
+
+	if _, err := b.workspace.Authorize(ctx, board.WorkspaceID.Int64, userID, entity.WorkspaceRoleOwner, entity.WorkspaceRoleAdmin); err != nil {
+		return board, err
+	}
+
+	return board, nil
+}
+
+func (b *board) getManagedColumn(ctx context.Context, selectParam entity.BoardColumnParam, userID int64) (entity.BoardColumn, error) {
+	if _, err := b.getManagedBoard(ctx, selectParam.BoardID.Int64, userID); err != nil {
+		return entity.BoardColumn{}, err
+	}
+
+	return b.boardColumn.Get(ctx, entity.BoardColumnParam{
+		ID:      selectParam.ID,
+		BoardID: selectParam.BoardID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+}
+
+// getColumns return the columns of the board from left to right
+func (b *board) getColumns(ctx context.Context, boardID int64) ([]entity.BoardColumn, error) {
+	columns, _, err := b.boardColumn.GetList(ctx, entity.BoardColumnParam{
+		BoardID: null.Int64From(boardID),
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"column_order", "id"},
+		},
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return columns, nil
+}
+
+// columnTask is the task on a column with the rank key it is ordered by
+type columnTask struct {
+	task     entity.Task
+	position string
+}
+
+// groupTasks put the tasks of the board on the columns in their order
+func (b *board) groupTasks(ctx context.Context, board entity.Board, columns []entity.BoardColumn) ([]entity.BoardColumn, error) {
+	tasks, err := b.getTasks(ctx, board)
+	if err != nil {
+		return nil, err
+	}
+
+	boardTasks, _, err := b.boardTask.GetList(ctx, entity.BoardTaskParam{
+		BoardID: null.Int64From(board.ID),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := placeTasks(columns, tasks, boardTasks)
+	for i := range columns {
+		columns[i].Tasks = []entity.Task{}
+		for _, columnTask := range grouped[i] {
+			columns[i].Tasks = append(columns[i].Tasks, columnTask.task)
+		}
+	}
+
+	return columns, nil
+}
+
+// getTasks return the top level tasks shown on the board
+func (b *board) getTasks(ctx context.Context, board entity.Board) ([]entity.Task, error) {
+	taskParam := entity.TaskParam{
+		TopLevelOnly: true,
+		QueryOption: query.Option{
+			DisableLimit: true,
+		},
+	}
+	if board.WorkspaceID.Valid {
+		taskParam.WorkspaceID = board.WorkspaceID
+	} else {
+		taskParam.UserId = null.Int64From(board.UserID)
+		taskParam.PersonalOnly = true
+	}
+
+	tasks, _, err := b.task.GetList(ctx, taskParam)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// placeTasks group the tasks by the columns. The task which was moved stays on its column unless its status no longer
+// matches the status column, any other task goes to the column of its status or the first column. The tasks are
+// ordered by the rank key of their place, or the rank key of the task when it was never moved, then by their id
+func placeTasks(columns []entity.BoardColumn, tasks []entity.Task, boardTasks []entity.BoardTask) [][]columnTask {
+	grouped := make([][]columnTask, len(columns))
+	if len(columns) == 0 {
+		return grouped
+	}
+
+	placed := map[int64]entity.BoardTask{}
+	for _, boardTask := range boardTasks {
+		placed[boardTask.TaskID] = boardTask
+	}
+
+	columnIndexes := map[int64]int{}
+	statusIndexes := map[string]int{}
+	for i, column := range columns {
+		columnIndexes[column.ID] = i
+		if column.ColumnType == entity.BoardColumnTypeStatus {
+			statusIndexes[column.TaskStatus.String] = i
+		}
+	}
+
+	for _, task := range tasks {
+		if boardTask, ok := placed[task.ID]; ok {
+			if i, ok := columnIndexes[boardTask.ColumnID]; ok {
+				column := columns[i]
+				if column.ColumnType == entity.BoardColumnTypeCustom || column.TaskStatus.String == task.TaskStatus {
+					grouped[i] = append(grouped[i], columnTask{task: task, position: boardTask.Position})
+					continue
+				}
+			}
+		}
+
+		i, ok := statusIndexes[task.TaskStatus]
+		if !ok {
+			i = 0
+		}
+		grouped[i] = append(grouped[i], columnTask{task: task, position: task.Position})
+	}
+
+	for i := range grouped {
+		columnTasks := grouped[i]
+		sort.SliceStable(columnTasks, func(x, y int) bool {
+			if columnTasks[x].position != columnTasks[y].position {
+				return columnTasks[x].position < columnTasks[y].position
+			}
+			return columnTasks[x].task.ID < columnTasks[y].task.ID
+		})
+	}
+
+	return grouped
+}
+
+// isOnBoard return true when the task is shown on the board, the subtasks are shown by their parent
+func isOnBoard(board entity.Board, task entity.Task) bool {
+	if task.ParentID.Valid {
+		return false
+	}
+
+	if board.WorkspaceID.Valid {
+		return task.WorkspaceID == board.WorkspaceID
+	}
+
+	return !task.WorkspaceID.Valid && task.UserId == board.UserID
+}
+
+// validateColumn fill the type of the column and make sure a task status has only one column on the board
+func validateColumn(column *entity.CreateBoardColumnParam, statuses map[string]bool) error {
+	column.Name = strings.TrimSpace(column.Name)
+	if column.Name == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "name of the column is required")
+	}
+
+	if column.ColumnType == "" {
+		column.ColumnType = entity.BoardColumnTypeCustom
+		if column.TaskStatus.Valid && column.TaskStatus.String != "" {
+			column.ColumnType = entity.BoardColumnTypeStatus
+		}
+	}
+
+	switch column.ColumnType {
+	case entity.BoardColumnTypeCustom:
+		column.TaskStatus = null.String{}
+	case entity.BoardColumnTypeStatus:
+		switch column.TaskStatus.String {
+		case entity.TaskStatusTodo, entity.TaskStatusOnGoing, entity.TaskStatusDone:
+		default:
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid taskStatus %q of column %q, must be one of todo, ongoing, done", column.TaskStatus.String, column.Name))
+		}
+
+		if statuses[column.TaskStatus.String] {
+			return errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("board already has a column for %s tasks", column.TaskStatus.String))
+		}
+		statuses[column.TaskStatus.String] = true
+	default:
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid columnType %q, must be one of status, custom", column.ColumnType))
+	}
+
+	return nil
+}
+
+// defaultColumns return a status column for every task status
+func defaultColumns() []entity.CreateBoardColumnParam {
+	return []entity.CreateBoardColumnParam{
+		{Name: "To Do", ColumnType: entity.BoardColumnTypeStatus, TaskStatus: null.StringFrom(entity.TaskStatusTodo)},
+		{Name: "On Going", ColumnType: entity.BoardColumnTypeStatus, TaskStatus: null.StringFrom(entity.TaskStatusOnGoing)},
+		{Name: "Done", ColumnType: entity.BoardColumnTypeStatus, TaskStatus: null.StringFrom(entity.TaskStatusDone)},
+	}
+}
@@ -0,0 +1,151 @@
+package calendar
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	userDom "github.com/adiatma85/gg-project/src/business/domain/user"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type Interface interface {
+	GenerateToken(ctx context.Context) (entity.CalendarTokenResponse, error)
+	RevokeToken(ctx context.Context) error
+	GetFeed(ctx context.Context, token string) (entity.Calendar, error)
+}
+
+type InitParam struct {
+	Log     log.Interface
+	User    userDom.Interface
+	Task    taskUc.CalendarInterface
+	JwtAuth jwtAuth.Interface
+}
+
+type calendar struct {
+	log     log.Interface
+	user    userDom.Interface
+	task    taskUc.CalendarInterface
+	jwtAuth jwtAuth.Interface
+}
+
+var Now = time.Now
+
+const (
+	// Number of random bytes of the token
+	tokenLength = 32
+
+	// Window of the feed relative to now
+	feedPastWindow   = -6 * 30 * 24 * time.Hour
+	feedFutureWindow = 365 * 24 * time.Hour
+
+	FeedPath = "/public/v1/calendar/%s.ics"
+)
+
+func Init(param InitParam) Interface {
+	c := &calendar{
+		log:     param.Log,
+		user:    param.User,
+		task:    param.Task,
+		jwtAuth: param.JwtAuth,
+	}
+
+	return c
+}
+
+// hashToken return the hex SHA-256 of the token, only the hash is stored so a leaked database does not leak the feeds
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateToken create a new token of the caller's feed, the previous token stops working immediately
+func (c *calendar) GenerateToken(ctx context.Context) (entity.CalendarTokenResponse, error) {
+	user, err := c.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.CalendarTokenResponse{}, err
+	}
+
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return entity.CalendarTokenResponse{}, errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	updateParam := entity.UpdateUserParam{
+		CalendarToken: null.StringFrom(hashToken(token)),
+		UpdatedAt:     null.TimeFrom(Now()),
+		UpdatedBy:     null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	if err := c.user.Update(ctx, updateParam, entity.UserParam{ID: null.Int64From(user.User.ID)}); err != nil {
+		return entity.CalendarTokenResponse{}, err
+	}
+
+	return entity.CalendarTokenResponse{
+		Token: token,
+		Path:  fmt.Sprintf(FeedPath, token),
+	}, nil
+}
+
+// RevokeToken disable the caller's feed until a new token is generated
+func (c *calendar) RevokeToken(ctx context.Context) error {
+	user, err := c.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	updateParam := entity.UpdateUserParam{
+		CalendarToken: null.String{SqlNull: true},
+		UpdatedAt:     null.TimeFrom(Now()),
+		UpdatedBy:     null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return c.user.Update(ctx, updateParam, entity.UserParam{ID: null.Int64From(user.User.ID)})
+}
+
+// GetFeed return the calendar of the owner of the token
+func (c *calendar) GetFeed(ctx context.Context, token string) (entity.Calendar, error) {
+	if token == "" {
+		return entity.Calendar{}, errors.NewWithCode(codes.CodeNotFound, "calendar not found")
+	}
+
+	user, err := c.user.Get(ctx, entity.UserParam{
+		CalendarToken: null.StringFrom(hashToken(token)),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		// Same error for unknown and revoked token
+		return entity.Calendar{}, errors.NewWithCode(codes.CodeNotFound, "calendar not found")
+	} else if err != nil {
+		return entity.Calendar{}, err
+	}
+
+	now := Now()
+	events, err := c.task.GetCalendarEvents(ctx, entity.TaskCalendarParam{
+		UserID: user.ID,
+		From:   now.Add(feedPastWindow),
+		To:     now.Add(feedFutureWindow),
+	})
+	if err != nil {
+		return entity.Calendar{}, err
+	}
+
+	return entity.Calendar{
+		Name:   fmt.Sprintf("%s's tasks", user.DisplayName),
+		Events: events,
+	}, nil
+}
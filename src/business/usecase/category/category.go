@@ -7,6 +7,7 @@ import (
 
 	categoryDom "github.com/adiatma85/gg-project/src/business/domain/category"
 	"github.com/adiatma85/gg-project/src/business/entity"
+	workspaceUc "github.com/adiatma85/gg-project/src/business/usecase/workspace"
 	"github.com/adiatma85/own-go-sdk/jwtAuth"
 	"github.com/adiatma85/own-go-sdk/log"
 	"github.com/adiatma85/own-go-sdk/null"
@@ -21,24 +22,27 @@ type Interface interface {
 }
 
 type InitParam struct {
-	Log      log.Interface
-	Category categoryDom.Interface
-	JwtAuth  jwtAuth.Interface
+	Log       log.Interface
+	Category  categoryDom.Interface
+	Workspace workspaceUc.Interface
+	JwtAuth   jwtAuth.Interface
 }
 
 type category struct {
-	log      log.Interface
-	category categoryDom.Interface
-	jwtAuth  jwtAuth.Interface
+	log       log.Interface
+	category  categoryDom.Interface
+	workspace workspaceUc.Interface
+	jwtAuth   jwtAuth.Interface
 }
 
 var Now = time.Now
 
 func Init(param InitParam) Interface {
 	c := &category{
-		log:      param.Log,
-		category: param.Category,
-		jwtAuth:  param.JwtAuth,
+		log:       param.Log,
+		category:  param.Category,
+		workspace: param.Workspace,
+		jwtAuth:   param.JwtAuth,
 	}
 
 	return c
@@ -50,6 +54,13 @@ func (c *category) Create(ctx context.Context, req entity.CreateCategoryParam) (
 		return entity.Category{}, err
 	}
 
+	// Every member can add a category to the workspace
+	if req.WorkspaceID.Valid {
+		if _, err := c.workspace.Authorize(ctx, req.WorkspaceID.Int64, user.User.ID); err != nil {
+			return entity.Category{}, err
+		}
+	}
+
 	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
 	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
 
@@ -57,6 +68,15 @@ func (c *category) Create(ctx context.Context, req entity.CreateCategoryParam) (
 }
 
 func (c *category) Get(ctx context.Context, params entity.CategoryParam) (entity.Category, error) {
+	user, err := c.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Category{}, err
+	}
+
+	if err := c.applyAccessFilter(ctx, &params, user.User.ID); err != nil {
+		return entity.Category{}, err
+	}
+
 	return c.category.Get(ctx, params)
 }
 
@@ -64,6 +84,15 @@ func (c *category) GetList(ctx context.Context, params entity.CategoryParam) ([]
 	params.IncludePagination = true
 	params.QueryOption.IsActive = true
 
+	user, err := c.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.applyAccessFilter(ctx, &params, user.User.ID); err != nil {
+		return nil, nil, err
+	}
+
 	categories, pg, err := c.category.GetList(ctx, params)
 	if err != nil {
 		return nil, nil, err
@@ -79,10 +108,15 @@ func (c *category) Update(ctx context.Context, updateParam entity.UpdateCategory
 		return err
 	}
 
+	category, err := c.getManagedCategory(ctx, selectParam, user.User.ID)
+	if err != nil {
+		return err
+	}
+
 	updateParam.UpdatedAt = null.TimeFrom(Now())
 	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
 
-	return c.category.Update(ctx, updateParam, selectParam)
+	return c.category.Update(ctx, updateParam, entity.CategoryParam{ID: null.Int64From(category.ID)})
 }
 
 func (c *category) Delete(ctx context.Context, selectParam entity.CategoryParam) error {
@@ -91,11 +125,51 @@ func (c *category) Delete(ctx context.Context, selectParam entity.CategoryParam)
 		return err
 	}
 
+	category, err := c.getManagedCategory(ctx, selectParam, user.User.ID)
+	if err != nil {
+		return err
+	}
+
 	deleteParam := entity.UpdateCategoryParam{
 		Status:    null.Int64From(-1),
 		DeletedAt: null.TimeFrom(Now()),
 		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
 	}
 
-	return c.category.Update(ctx, deleteParam, selectParam)
+	return c.category.Update(ctx, deleteParam, entity.CategoryParam{ID: null.Int64From(category.ID)})
+}
+
+// applyAccessFilter restrict the categories to the global ones and the ones of the workspaces of the user, admin can
+// access every category
+func (c *category) applyAccessFilter(ctx context.Context, params *entity.CategoryParam, userID int64) error {
+	if entity.IsSuperAdmin(ctx, userID) {
+		return nil
+	}
+
+	workspaceIDs, err := c.workspace.GetWorkspaceIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	params.RestrictWorkspace = true
+	params.AccessWorkspaceIDs = workspaceIDs
+
+	return nil
+}
+
+// getManagedCategory return the category which can be changed by the user, the category of a workspace can only be
+// changed by the owner and the admins of the workspace
+func (c *category) getManagedCategory(ctx context.Context, selectParam entity.CategoryParam, userID int64) (entity.Category, error) {
+	category, err := c.Get(ctx, selectParam)
+	if err != nil {
+		return category, err
+	}
+
+	if category.WorkspaceID.Valid {
+		if _, err := c.workspace.Authorize(ctx, category.WorkspaceID.Int64, userID, entity.WorkspaceRoleOwner, entity.WorkspaceRoleAdmin); err != nil {
+			return category, err
+		}
+	}
+
+	return category, nil
 }
@@ -0,0 +1,160 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	commentDom "github.com/adiatma85/gg-project/src/business/domain/comment"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+)
+
+type Interface interface {
+	Create(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTaskCommentParam) (entity.TaskComment, error)
+	GetList(ctx context.Context, params entity.TaskCommentParam) ([]entity.TaskComment, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTaskCommentParam, selectParam entity.TaskCommentParam) error
+	Delete(ctx context.Context, selectParam entity.TaskCommentParam) error
+}
+
+type InitParam struct {
+	Log     log.Interface
+	Comment commentDom.Interface
+	Task    taskUc.Interface
+	JwtAuth jwtAuth.Interface
+}
+
+type comment struct {
+	log     log.Interface
+	comment commentDom.Interface
+	task    taskUc.Interface
+	jwtAuth jwtAuth.Interface
+}
+
+var Now = time.Now
+
+func Init(param InitParam) Interface {
+	c := &comment{
+		log:     param.Log,
+		comment: param.Comment,
+		task:    param.Task,
+		jwtAuth: param.JwtAuth,
+	}
+
+	return c
+}
+
+func (c *comment) Create(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTaskCommentParam) (entity.TaskComment, error) {
+	user, err := c.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TaskComment{}, err
+	}
+
+	req.Content = strings.TrimSpace(req.Content)
+	if req.Content == "" {
+		return entity.TaskComment{}, errors.NewWithCode(codes.CodeBadRequest, "comment content can not be empty")
+	}
+
+	// Make sure the task is accessible by the user
+	task, err := c.task.Get(ctx, entity.TaskParam{ID: taskParam.ID})
+	if err != nil {
+		return entity.TaskComment{}, err
+	}
+
+	req.TaskID = task.ID
+	req.UserID = user.User.ID
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return c.comment.Create(ctx, req)
+}
+
+func (c *comment) GetList(ctx context.Context, params entity.TaskCommentParam) ([]entity.TaskComment, *entity.Pagination, error) {
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	// Make sure the task is accessible by the user
+	task, err := c.task.Get(ctx, entity.TaskParam{ID: params.TaskID})
+	if err != nil {
+		return []entity.TaskComment{}, &entity.Pagination{}, err
+	}
+
+	params.TaskID = null.Int64From(task.ID)
+
+	// Oldest comment first so the thread can be read from top to bottom
+	params.SortBy = []string{"id"}
+
+	return c.comment.GetList(ctx, params)
+}
+
+func (c *comment) Update(ctx context.Context, updateParam entity.UpdateTaskCommentParam, selectParam entity.TaskCommentParam) error {
+	user, err := c.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	updateParam.Content = strings.TrimSpace(updateParam.Content)
+	if updateParam.Content == "" {
+		return errors.NewWithCode(codes.CodeBadRequest, "comment content can not be empty")
+	}
+
+	comment, err := c.getComment(ctx, selectParam)
+	if err != nil {
+		return err
+	}
+
+	// Only the author can edit the comment
+	if comment.UserID != user.User.ID {
+		return errors.NewWithCode(codes.CodeForbidden, "only the author can edit the comment")
+	}
+
+	updateParam.UpdatedAt = null.TimeFrom(Now())
+	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return c.comment.Update(ctx, updateParam, entity.TaskCommentParam{ID: null.Int64From(comment.ID)})
+}
+
+func (c *comment) Delete(ctx context.Context, selectParam entity.TaskCommentParam) error {
+	user, err := c.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	comment, err := c.getComment(ctx, selectParam)
+	if err != nil {
+		return err
+	}
+
+	// The author and admin can delete the comment
+	if comment.UserID != user.User.ID && !entity.IsSuperAdmin(ctx, user.User.ID) {
+		return errors.NewWithCode(codes.CodeForbidden, "only the author can delete the comment")
+	}
+
+	deleteParam := entity.UpdateTaskCommentParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return c.comment.Update(ctx, deleteParam, entity.TaskCommentParam{ID: null.Int64From(comment.ID)})
+}
+
+// getComment return the active comment of the task which is accessible by the user
+func (c *comment) getComment(ctx context.Context, params entity.TaskCommentParam) (entity.TaskComment, error) {
+	task, err := c.task.Get(ctx, entity.TaskParam{ID: params.TaskID})
+	if err != nil {
+		return entity.TaskComment{}, err
+	}
+
+	return c.comment.Get(ctx, entity.TaskCommentParam{
+		ID:     params.ID,
+		TaskID: null.Int64From(task.ID),
+		Status: null.Int64From(1),
+	})
+}
@@ -0,0 +1,404 @@
+package focus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	focusSessionDom "github.com/adiatma85/gg-project/src/business/domain/focussession"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	achievementUc "github.com/adiatma85/gg-project/src/business/usecase/achievement"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+)
+
+type Interface interface {
+	Start(ctx context.Context, taskParam entity.TaskParam, req entity.CreateFocusSessionParam) (entity.FocusSession, error)
+	Complete(ctx context.Context, selectParam entity.FocusSessionParam) (entity.FocusSession, error)
+	Interrupt(ctx context.Context, selectParam entity.FocusSessionParam, req entity.InterruptFocusSessionParam) (entity.FocusSession, error)
+	GetCurrent(ctx context.Context) (entity.FocusSession, error)
+	GetList(ctx context.Context, params entity.FocusSessionParam) ([]entity.FocusSession, *entity.Pagination, error)
+	GetDaily(ctx context.Context, params entity.FocusReportParam) ([]entity.FocusDay, error)
+}
+
+type Config struct {
+	DefaultWorkMinutes  int64
+	DefaultBreakMinutes int64
+	MaxWorkMinutes      int64
+	MaxBreakMinutes     int64
+}
+
+type InitParam struct {
+	Log          log.Interface
+	FocusSession focusSessionDom.Interface
+	Task         taskUc.Interface
+	Achievement  achievementUc.Interface
+	JwtAuth      jwtAuth.Interface
+	Conf         Config
+}
+
+type focus struct {
+	log          log.Interface
+	focusSession focusSessionDom.Interface
+	task         taskUc.Interface
+	achievement  achievementUc.Interface
+	jwtAuth      jwtAuth.Interface
+	conf         Config
+}
+
+var Now = time.Now
+
+const (
+	// Interrupt reason is stored in a VARCHAR(255) column
+	maxReasonLength = 255
+
+	// Default and longest range of the daily report
+	defaultReportRange = 7 * 24 * time.Hour
+	maxReportRange     = 366 * 24 * time.Hour
+)
+
+func Init(param InitParam) Interface {
+	if param.Conf.DefaultWorkMinutes < 1 {
+		param.Conf.DefaultWorkMinutes = 25
+	}
+
+	if param.Conf.DefaultBreakMinutes < 1 {
+		param.Conf.DefaultBreakMinutes = 5
+	}
+
+	if param.Conf.MaxWorkMinutes < param.Conf.DefaultWorkMinutes {
+		param.Conf.MaxWorkMinutes = 120
+	}
+
+	if param.Conf.MaxBreakMinutes < param.Conf.DefaultBreakMinutes {
+		param.Conf.MaxBreakMinutes = 60
+	}
+
+	f := &focus{
+		log:          param.Log,
+		focusSession: param.FocusSession,
+		task:         param.Task,
+		achievement:  param.Achievement,
+		jwtAuth:      param.JwtAuth,
+		conf:         param.Conf,
+	}
+
+	return f
+}
+
+// Start begin a focus session on the task, the user can only focus on one task at a time
+func (f *focus) Start(ctx context.Context, taskParam entity.TaskParam, req entity.CreateFocusSessionParam) (entity.FocusSession, error) {
+	user, err := f.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.FocusSession{}, err
+	}
+
+	if req.WorkMinutes == 0 {
+		req.WorkMinutes = f.conf.DefaultWorkMinutes
+	}
+
+	if req.BreakMinutes == 0 {
+		req.BreakMinutes = f.conf.DefaultBreakMinutes
+	}
+
+	if req.WorkMinutes < 1 || req.WorkMinutes > f.conf.MaxWorkMinutes {
+		return entity.FocusSession{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("workMinutes must be between 1 and %d", f.conf.MaxWorkMinutes))
+	} else if req.BreakMinutes < 1 || req.BreakMinutes > f.conf.MaxBreakMinutes {
+		return entity.FocusSession{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("breakMinutes must be between 1 and %d", f.conf.MaxBreakMinutes))
+	}
+
+	// Make sure the task is accessible by the user
+	task, err := f.task.Get(ctx, entity.TaskParam{ID: taskParam.ID})
+	if err != nil {
+		return entity.FocusSession{}, err
+	}
+
+	running, err := f.getRunning(ctx, user.User.ID)
+	if err == nil {
+		return entity.FocusSession{}, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("focus session %d on task %d is still running, interrupt it first", running.ID, running.TaskID))
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return entity.FocusSession{}, err
+	}
+
+	now := Now()
+	req.TaskID = task.ID
+	req.UserID = user.User.ID
+	req.State = entity.FocusSessionStateRunning
+	req.StartedAt = null.TimeFrom(now)
+	req.EndsAt = null.TimeFrom(now.Add(time.Duration(req.WorkMinutes) * time.Minute))
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	// The unique running index of the table rejects a second session started at the same moment
+	session, err := f.focusSession.Create(ctx, req)
+	if err != nil {
+		return session, err
+	}
+
+	session.ProcessPhase(Now())
+
+	return session, nil
+}
+
+// Complete end the session once its work part is over and start its break, a session which is already completed
+// is returned as is
+func (f *focus) Complete(ctx context.Context, selectParam entity.FocusSessionParam) (entity.FocusSession, error) {
+	session, err := f.find(ctx, selectParam)
+	if err != nil {
+		return session, err
+	}
+
+	switch session.State {
+	case entity.FocusSessionStateCompleted:
+	case entity.FocusSessionStateInterrupted:
+		return session, errors.NewWithCode(codes.CodeConflict, "focus session is already interrupted")
+	default:
+		if Now().Before(session.EndsAt.Time) {
+			return session, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("focus session ends at %s, interrupt it to stop earlier", session.EndsAt.Time.Format(time.RFC3339)))
+		}
+
+		if session, err = f.completeElapsed(ctx, session); err != nil {
+			return session, err
+		}
+	}
+
+	session.ProcessPhase(Now())
+
+	return session, nil
+}
+
+// Interrupt end the running session before its work part is over
+func (f *focus) Interrupt(ctx context.Context, selectParam entity.FocusSessionParam, req entity.InterruptFocusSessionParam) (entity.FocusSession, error) {
+	if len(req.Reason) > maxReasonLength {
+		return entity.FocusSession{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("reason must not be longer than %d characters", maxReasonLength))
+	}
+
+	session, err := f.get(ctx, selectParam)
+	if err != nil {
+		return session, err
+	}
+
+	if session.State != entity.FocusSessionStateRunning {
+		return session, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("focus session is already %s", session.State))
+	}
+
+	now := Now()
+	session, err = f.end(ctx, session, entity.UpdateFocusSessionParam{
+		State:           entity.FocusSessionStateInterrupted,
+		EndedAt:         null.TimeFrom(now),
+		FocusSeconds:    null.Int64From(int64(now.Sub(session.StartedAt.Time) / time.Second)),
+		InterruptReason: req.Reason,
+	})
+	if err != nil {
+		return session, err
+	}
+
+	session.ProcessPhase(Now())
+
+	return session, nil
+}
+
+func (f *focus) GetCurrent(ctx context.Context) (entity.FocusSession, error) {
+	user, err := f.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.FocusSession{}, err
+	}
+
+	now := Now()
+
+	running, err := f.getRunning(ctx, user.User.ID)
+	if err == nil {
+		running.ProcessPhase(now)
+		return running, nil
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return running, err
+	}
+
+	// The completed session stays current until its break is over
+	last, err := f.focusSession.Get(ctx, entity.FocusSessionParam{
+		UserID:     null.Int64From(user.User.ID),
+		State:      entity.FocusSessionStateCompleted,
+		EndedAtGTE: null.TimeFrom(now.Add(-time.Duration(f.conf.MaxBreakMinutes) * time.Minute)),
+		Status:     null.Int64From(1),
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"-ended_at"},
+		},
+	})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return entity.FocusSession{}, errors.NewWithCode(codes.CodeNotFound, "no running focus session")
+	} else if err != nil {
+		return last, err
+	}
+
+	last.ProcessPhase(now)
+	if last.Phase != entity.FocusSessionPhaseBreak {
+		return entity.FocusSession{}, errors.NewWithCode(codes.CodeNotFound, "no running focus session")
+	}
+
+	return last, nil
+}
+
+func (f *focus) GetList(ctx context.Context, params entity.FocusSessionParam) ([]entity.FocusSession, *entity.Pagination, error) {
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	user, err := f.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return []entity.FocusSession{}, &entity.Pagination{}, err
+	}
+
+	switch params.State {
+	case "", entity.FocusSessionStateRunning, entity.FocusSessionStateCompleted, entity.FocusSessionStateInterrupted:
+	default:
+		return []entity.FocusSession{}, &entity.Pagination{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid state %q, must be one of running, completed, interrupted", params.State))
+	}
+
+	// The running session which work part is over is completed first, so the list shows its final state
+	if _, err := f.getRunning(ctx, user.User.ID); err != nil && errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return []entity.FocusSession{}, &entity.Pagination{}, err
+	}
+
+	params.UserID = null.Int64From(user.User.ID)
+	params.SortBy = []string{"-started_at"}
+
+	sessions, pg, err := f.focusSession.GetList(ctx, params)
+	if err != nil {
+		return sessions, pg, err
+	}
+
+	now := Now()
+	for i := range sessions {
+		sessions[i].ProcessPhase(now)
+	}
+
+	return sessions, pg, nil
+}
+
+// GetDaily report the focus minutes and the completed and interrupted sessions of the user per day
+func (f *focus) GetDaily(ctx context.Context, params entity.FocusReportParam) ([]entity.FocusDay, error) {
+	user, err := f.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return []entity.FocusDay{}, err
+	}
+
+	if params.Location == nil {
+		params.Location = time.UTC
+	}
+
+	if params.To.IsZero() {
+		params.To = Now()
+	}
+
+	if params.From.IsZero() {
+		params.From = params.To.Add(-defaultReportRange)
+	}
+
+	if !params.To.After(params.From) {
+		return []entity.FocusDay{}, errors.NewWithCode(codes.CodeBadRequest, "to must be after from")
+	} else if params.To.Sub(params.From) > maxReportRange {
+		return []entity.FocusDay{}, errors.NewWithCode(codes.CodeBadRequest, "range must not be longer than 366 days")
+	}
+
+	// The running session which work part is over is counted as completed
+	if _, err := f.getRunning(ctx, user.User.ID); err != nil && errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return []entity.FocusDay{}, err
+	}
+
+	params.UserID = user.User.ID
+
+	return f.focusSession.GetDaily(ctx, params)
+}
+
+// get return the session of the user, the running session is completed when its work part is over
+func (f *focus) get(ctx context.Context, selectParam entity.FocusSessionParam) (entity.FocusSession, error) {
+	session, err := f.find(ctx, selectParam)
+	if err != nil {
+		return session, err
+	}
+
+	return f.completeElapsed(ctx, session)
+}
+
+// find return the session of the user as it is stored
+func (f *focus) find(ctx context.Context, selectParam entity.FocusSessionParam) (entity.FocusSession, error) {
+	user, err := f.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.FocusSession{}, err
+	}
+
+	return f.focusSession.Get(ctx, entity.FocusSessionParam{
+		ID:     selectParam.ID,
+		UserID: null.Int64From(user.User.ID),
+		Status: null.Int64From(1),
+	})
+}
+
+// getRunning return the running session of the user, the session which work part is over is completed and
+// not returned
+func (f *focus) getRunning(ctx context.Context, userID int64) (entity.FocusSession, error) {
+	session, err := f.focusSession.Get(ctx, entity.FocusSessionParam{
+		UserID: null.Int64From(userID),
+		State:  entity.FocusSessionStateRunning,
+		Status: null.Int64From(1),
+	})
+	if err != nil {
+		return session, err
+	}
+
+	if session, err = f.completeElapsed(ctx, session); err != nil {
+		return session, err
+	} else if session.State != entity.FocusSessionStateRunning {
+		return session, errors.NewWithCode(codes.CodeSQLRecordDoesNotExist, "no running focus session")
+	}
+
+	return session, nil
+}
+
+// completeElapsed complete the running session which work part is over, the client does not need to be online
+// when the session ends
+func (f *focus) completeElapsed(ctx context.Context, session entity.FocusSession) (entity.FocusSession, error) {
+	if session.State != entity.FocusSessionStateRunning || Now().Before(session.EndsAt.Time) {
+		return session, nil
+	}
+
+	return f.end(ctx, session, entity.UpdateFocusSessionParam{
+		State:        entity.FocusSessionStateCompleted,
+		EndedAt:      session.EndsAt,
+		FocusSeconds: null.Int64From(session.WorkMinutes * 60),
+	})
+}
+
+// end update the running session into its final state, the state condition keeps a concurrent request from
+// ending the same session twice
+func (f *focus) end(ctx context.Context, session entity.FocusSession, updateParam entity.UpdateFocusSessionParam) (entity.FocusSession, error) {
+	updateParam.UpdatedAt = null.TimeFrom(Now())
+	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", session.UserID))
+
+	if err := f.focusSession.Update(ctx, updateParam, entity.FocusSessionParam{
+		ID:    null.Int64From(session.ID),
+		State: entity.FocusSessionStateRunning,
+	}); err != nil {
+		return session, err
+	}
+
+	session, err := f.focusSession.Get(ctx, entity.FocusSessionParam{ID: null.Int64From(session.ID)})
+	if err != nil {
+		return session, err
+	}
+
+	// Achievement is a bonus, failing to evaluate it does not fail ending the session
+	if session.State == entity.FocusSessionStateCompleted {
+		if _, err := f.achievement.Evaluate(ctx, entity.AchievementEvent{
+			Type:   entity.AchievementEventFocusCompleted,
+			UserID: session.UserID,
+		}); err != nil {
+			f.log.Error(ctx, fmt.Sprintf("failed to evaluate achievements of user %d: %v", session.UserID, err))
+		}
+	}
+
+	return session, nil
+}
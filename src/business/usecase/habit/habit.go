@@ -0,0 +1,391 @@
+package habit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	habitCheckInDom "github.com/adiatma85/gg-project/src/business/domain/habitcheckin"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type Interface interface {
+	GetList(ctx context.Context, params entity.HabitParam) ([]entity.Habit, *entity.Pagination, error)
+	Get(ctx context.Context, params entity.HabitParam) (entity.Habit, error)
+	CheckIn(ctx context.Context, params entity.HabitParam, req entity.HabitCheckInRequest) (entity.HabitCheckIn, error)
+	UndoCheckIn(ctx context.Context, params entity.HabitParam, req entity.HabitCheckInRequest) error
+	GetHistory(ctx context.Context, params entity.HabitHistoryParam) (entity.HabitHistory, error)
+}
+
+type InitParam struct {
+	Log          log.Interface
+	HabitCheckIn habitCheckInDom.Interface
+	Task         taskUc.Interface
+	JwtAuth      jwtAuth.Interface
+}
+
+type habit struct {
+	log          log.Interface
+	habitCheckIn habitCheckInDom.Interface
+	task         taskUc.Interface
+	jwtAuth      jwtAuth.Interface
+}
+
+var Now = time.Now
+
+const (
+	// Default and longest range of the history heatmap
+	defaultHistoryRange = 365 * 24 * time.Hour
+	maxHistoryRange     = 366 * 24 * time.Hour
+)
+
+func Init(param InitParam) Interface {
+	h := &habit{
+		log:          param.Log,
+		habitCheckIn: param.HabitCheckIn,
+		task:         param.Task,
+		jwtAuth:      param.JwtAuth,
+	}
+
+	return h
+}
+
+// GetList return the daily and weekly habits of the user with their streaks, a habit is the first task of its series
+func (h *habit) GetList(ctx context.Context, params entity.HabitParam) ([]entity.Habit, *entity.Pagination, error) {
+	loc, err := loadLocation(params.Timezone)
+	if err != nil {
+		return []entity.Habit{}, &entity.Pagination{}, err
+	}
+
+	taskParam := entity.TaskParam{
+		SeriesOnly:      true,
+		PaginationParam: params.PaginationParam,
+	}
+	taskParam.SortBy = []string{"created_at"}
+
+	switch params.Periodic {
+	case "":
+		taskParam.Periodics = []string{entity.TaskPeriodicDaily, entity.TaskPeriodicWeekly}
+	case entity.TaskPeriodicDaily, entity.TaskPeriodicWeekly:
+		taskParam.Periodic = null.StringFrom(params.Periodic)
+	default:
+		return []entity.Habit{}, &entity.Pagination{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid periodic %q, must be one of daily, weekly", params.Periodic))
+	}
+
+	tasks, pg, err := h.task.GetList(ctx, taskParam)
+	if err != nil {
+		return []entity.Habit{}, &entity.Pagination{}, err
+	}
+
+	if len(tasks) == 0 {
+		return []entity.Habit{}, pg, nil
+	}
+
+	taskIDs := []int64{}
+	for _, task := range tasks {
+		taskIDs = append(taskIDs, task.ID)
+	}
+
+	checkIns, _, err := h.habitCheckIn.GetList(ctx, entity.HabitCheckInParam{
+		TaskIDs: taskIDs,
+		Status:  null.Int64From(1),
+		QueryOption: query.Option{
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return []entity.Habit{}, &entity.Pagination{}, err
+	}
+
+	days := map[int64][]time.Time{}
+	for _, checkIn := range checkIns {
+		days[checkIn.TaskID] = append(days[checkIn.TaskID], checkIn.CheckInDate.Time)
+	}
+
+	today := Now().In(loc)
+	habits := []entity.Habit{}
+	for _, task := range tasks {
+		habits = append(habits, newHabit(task, task.ID, days[task.ID], loc, today))
+	}
+
+	return habits, pg, nil
+}
+
+func (h *habit) Get(ctx context.Context, params entity.HabitParam) (entity.Habit, error) {
+	loc, err := loadLocation(params.Timezone)
+	if err != nil {
+		return entity.Habit{}, err
+	}
+
+	task, habitID, err := h.getHabitTask(ctx, params.ID)
+	if err != nil {
+		return entity.Habit{}, err
+	}
+
+	days, err := h.getCheckInDays(ctx, habitID)
+	if err != nil {
+		return entity.Habit{}, err
+	}
+
+	return newHabit(task, habitID, days, loc, Now().In(loc)), nil
+}
+
+// CheckIn mark the habit as done on the day, the task itself is not updated so no occurrence is spawned.
+// Checking in the same day again return the existing check in
+func (h *habit) CheckIn(ctx context.Context, params entity.HabitParam, req entity.HabitCheckInRequest) (entity.HabitCheckIn, error) {
+	user, err := h.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.HabitCheckIn{}, err
+	}
+
+	day, err := parseCheckInDay(req)
+	if err != nil {
+		return entity.HabitCheckIn{}, err
+	}
+
+	_, habitID, err := h.getHabitTask(ctx, params.ID)
+	if err != nil {
+		return entity.HabitCheckIn{}, err
+	}
+
+	return h.habitCheckIn.Create(ctx, entity.CreateHabitCheckInParam{
+		TaskID:      habitID,
+		UserID:      user.User.ID,
+		CheckInDate: null.DateFrom(day),
+		CreatedBy:   null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+		UpdatedBy:   null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	})
+}
+
+// UndoCheckIn delete the check in of the day
+func (h *habit) UndoCheckIn(ctx context.Context, params entity.HabitParam, req entity.HabitCheckInRequest) error {
+	user, err := h.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	day, err := parseCheckInDay(req)
+	if err != nil {
+		return err
+	}
+
+	_, habitID, err := h.getHabitTask(ctx, params.ID)
+	if err != nil {
+		return err
+	}
+
+	selectParam := entity.HabitCheckInParam{
+		TaskID:      null.Int64From(habitID),
+		CheckInDate: null.DateFrom(day),
+		Status:      null.Int64From(1),
+	}
+
+	if _, err := h.habitCheckIn.Get(ctx, selectParam); errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return errors.NewWithCode(codes.CodeNotFound, fmt.Sprintf("habit is not checked in on %s", day.Format(entity.ConstLayoutDateFormat)))
+	} else if err != nil {
+		return err
+	}
+
+	return h.habitCheckIn.Update(ctx, entity.UpdateHabitCheckInParam{
+		Status:    null.Int64From(-1),
+		UpdatedAt: null.TimeFrom(Now()),
+		UpdatedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}, selectParam)
+}
+
+// GetHistory return the heatmap of the habit, one day per entry from the day of from to the day before to
+func (h *habit) GetHistory(ctx context.Context, params entity.HabitHistoryParam) (entity.HabitHistory, error) {
+	if params.Location == nil {
+		params.Location = time.UTC
+	}
+
+	if params.To.IsZero() {
+		params.To = Now()
+	}
+
+	if params.From.IsZero() {
+		params.From = params.To.Add(-defaultHistoryRange)
+	}
+
+	if !params.To.After(params.From) {
+		return entity.HabitHistory{}, errors.NewWithCode(codes.CodeBadRequest, "to must be after from")
+	} else if params.To.Sub(params.From) > maxHistoryRange {
+		return entity.HabitHistory{}, errors.NewWithCode(codes.CodeBadRequest, "range must not be longer than 366 days")
+	}
+
+	task, habitID, err := h.getHabitTask(ctx, params.ID)
+	if err != nil {
+		return entity.HabitHistory{}, err
+	}
+
+	// The streaks need every check in, the days of the range are picked from them
+	days, err := h.getCheckInDays(ctx, habitID)
+	if err != nil {
+		return entity.HabitHistory{}, err
+	}
+
+	today := Now().In(params.Location)
+	result := entity.HabitHistory{
+		Habit: newHabit(task, habitID, days, params.Location, today),
+		Days:  []entity.HabitDay{},
+	}
+
+	// The range is made of whole days, to is exclusive
+	from := dayOf(params.From.In(params.Location))
+	to := dayOf(params.To.Add(-time.Nanosecond).In(params.Location))
+	result.From = from.Format(entity.ConstLayoutDateFormat)
+	result.To = to.Format(entity.ConstLayoutDateFormat)
+
+	checkedDays := map[time.Time]bool{}
+	checkedPeriods := map[time.Time]bool{}
+	rangeDays := []time.Time{}
+	for _, day := range days {
+		day = dayOf(day)
+		checkedDays[day] = true
+		checkedPeriods[entity.HabitPeriodStart(task.Periodic, day)] = true
+		if !day.Before(from) && !day.After(to) {
+			rangeDays = append(rangeDays, day)
+		}
+	}
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		habitDay := entity.HabitDay{Date: day.Format(entity.ConstLayoutDateFormat)}
+		if checkedDays[day] {
+			habitDay.CheckedIn = true
+			habitDay.Level = 2
+		} else if checkedPeriods[entity.HabitPeriodStart(task.Periodic, day)] {
+			habitDay.Level = 1
+		}
+		result.Days = append(result.Days, habitDay)
+	}
+
+	// The rate is only counted from the start of the habit up to today
+	rateFrom, rateTo := from, to
+	if startedOn, err := time.Parse(entity.ConstLayoutDateFormat, result.Habit.StartedOn); err == nil && startedOn.After(rateFrom) {
+		rateFrom = startedOn
+	}
+	if todayDay := dayOf(today); todayDay.Before(rateTo) {
+		rateTo = todayDay
+	}
+
+	result.CheckIns = int64(len(rangeDays))
+	result.CompletionRate = entity.HabitRangeCompletionRate(task.Periodic, rangeDays, rateFrom, rateTo)
+
+	return result, nil
+}
+
+// getHabitTask return the first task of the series of the task and the habit id, only the daily and weekly tasks
+// are habits. The task usecase makes sure the task belongs to the user
+func (h *habit) getHabitTask(ctx context.Context, taskID null.Int64) (entity.Task, int64, error) {
+	task, err := h.task.Get(ctx, entity.TaskParam{ID: taskID})
+	if err != nil {
+		return task, 0, err
+	}
+
+	if task.Periodic != entity.TaskPeriodicDaily && task.Periodic != entity.TaskPeriodicWeekly {
+		return task, 0, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("task %d is not a habit, only daily and weekly tasks are", task.ID))
+	}
+
+	if !task.SeriesID.Valid {
+		return task, task.ID, nil
+	}
+
+	// The first task may be deleted while the series goes on, the given task is shown instead
+	first, err := h.task.Get(ctx, entity.TaskParam{ID: task.SeriesID})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return task, task.SeriesID.Int64, nil
+	} else if err != nil {
+		return task, 0, err
+	}
+
+	return first, first.ID, nil
+}
+
+// getCheckInDays return the days of every check in of the habit
+func (h *habit) getCheckInDays(ctx context.Context, habitID int64) ([]time.Time, error) {
+	checkIns, _, err := h.habitCheckIn.GetList(ctx, entity.HabitCheckInParam{
+		TaskID: null.Int64From(habitID),
+		Status: null.Int64From(1),
+		QueryOption: query.Option{
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	days := []time.Time{}
+	for _, checkIn := range checkIns {
+		days = append(days, checkIn.CheckInDate.Time)
+	}
+
+	return days, nil
+}
+
+func newHabit(task entity.Task, habitID int64, days []time.Time, loc *time.Location, today time.Time) entity.Habit {
+	result := entity.Habit{
+		ID:          habitID,
+		Title:       task.Title,
+		Description: task.Description,
+		Periodic:    task.Periodic,
+		CreatedAt:   task.CreatedAt,
+	}
+
+	startedOn := today
+	if task.CreatedAt.Valid {
+		startedOn = task.CreatedAt.Time.In(loc)
+	}
+
+	result.ProcessStats(days, startedOn, today)
+
+	return result
+}
+
+// parseCheckInDay return the day of the check in as midnight UTC, the default is today in the time zone
+func parseCheckInDay(req entity.HabitCheckInRequest) (time.Time, error) {
+	loc, err := loadLocation(req.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	today := dayOf(Now().In(loc))
+	if req.Date == "" {
+		return today, nil
+	}
+
+	day, err := time.Parse(entity.ConstLayoutDateFormat, req.Date)
+	if err != nil {
+		return day, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid date %q, must be a date (2006-01-02)", req.Date))
+	} else if day.After(today) {
+		return day, errors.NewWithCode(codes.CodeBadRequest, "can not check in a day in the future")
+	}
+
+	return day, nil
+}
+
+func loadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid timezone %q", timezone))
+	}
+
+	return loc, nil
+}
+
+// dayOf return the date of t as midnight UTC, the same value a DATE column is read as
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
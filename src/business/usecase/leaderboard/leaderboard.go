@@ -0,0 +1,414 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	leaderboardDom "github.com/adiatma85/gg-project/src/business/domain/leaderboard"
+	userDom "github.com/adiatma85/gg-project/src/business/domain/user"
+	userProgressDom "github.com/adiatma85/gg-project/src/business/domain/userprogress"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	workspaceUc "github.com/adiatma85/gg-project/src/business/usecase/workspace"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 100
+)
+
+type Interface interface {
+	Record(ctx context.Context, userID int64, xp int64) error
+	GetList(ctx context.Context, param entity.LeaderboardParam) ([]entity.LeaderboardEntry, *entity.Pagination, error)
+	GetMe(ctx context.Context, param entity.LeaderboardParam) (entity.LeaderboardEntry, error)
+	GetSettings(ctx context.Context) (entity.LeaderboardSettings, error)
+	UpdateSettings(ctx context.Context, settings entity.LeaderboardSettings) (entity.LeaderboardSettings, error)
+}
+
+type Config struct {
+	Timezone string // IANA time zone of the weekly leaderboard, the week starts on Monday midnight, default is UTC
+}
+
+type InitParam struct {
+	Log          log.Interface
+	Leaderboard  leaderboardDom.Interface
+	User         userDom.Interface
+	UserProgress userProgressDom.Interface
+	Workspace    workspaceUc.Interface
+	JwtAuth      jwtAuth.Interface
+	Conf         Config
+}
+
+type leaderboard struct {
+	log          log.Interface
+	leaderboard  leaderboardDom.Interface
+	user         userDom.Interface
+	userProgress userProgressDom.Interface
+	workspace    workspaceUc.Interface
+	jwtAuth      jwtAuth.Interface
+	location     *time.Location
+}
+
+var Now = time.Now
+
+func Init(param InitParam) Interface {
+	l := &leaderboard{
+		log:          param.Log,
+		leaderboard:  param.Leaderboard,
+		user:         param.User,
+		userProgress: param.UserProgress,
+		workspace:    param.Workspace,
+		jwtAuth:      param.JwtAuth,
+		location:     time.UTC,
+	}
+
+	if param.Conf.Timezone != "" {
+		loc, err := time.LoadLocation(param.Conf.Timezone)
+		if err != nil {
+			l.log.Warn(context.Background(), fmt.Sprintf("invalid leaderboard timezone %q, UTC is used: %v", param.Conf.Timezone, err))
+		} else {
+			l.location = loc
+		}
+	}
+
+	return l
+}
+
+// Record add the XP and one done task to every leaderboard of the user, nothing is recorded when the user opted out
+func (l *leaderboard) Record(ctx context.Context, userID int64, xp int64) error {
+	user, err := l.user.Get(ctx, entity.UserParam{ID: null.Int64From(userID)})
+	if err != nil {
+		return err
+	}
+
+	if user.LeaderboardOptOut {
+		return nil
+	}
+
+	scores := map[string]int64{
+		entity.LeaderboardMetricXP:    xp,
+		entity.LeaderboardMetricTasks: 1,
+	}
+
+	for _, key := range l.userKeys(userID) {
+		if err := l.leaderboard.Incr(ctx, key, userID, scores[key.Metric]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetList return the ranking of the leaderboard with the names of the users, the default is the weekly XP leaderboard
+func (l *leaderboard) GetList(ctx context.Context, param entity.LeaderboardParam) ([]entity.LeaderboardEntry, *entity.Pagination, error) {
+	if _, err := l.jwtAuth.GetUserAuthInfo(ctx); err != nil {
+		return []entity.LeaderboardEntry{}, &entity.Pagination{}, err
+	}
+
+	key, err := l.key(param)
+	if err != nil {
+		return []entity.LeaderboardEntry{}, &entity.Pagination{}, err
+	}
+
+	if param.Limit < 1 {
+		param.Limit = defaultLimit
+	} else if param.Limit > maxLimit {
+		param.Limit = maxLimit
+	}
+
+	if param.Scope == entity.LeaderboardScopeWorkspace {
+		entries, err := l.getWorkspaceEntries(ctx, key, param.WorkspaceID)
+		if err != nil {
+			return []entity.LeaderboardEntry{}, &entity.Pagination{}, err
+		}
+
+		entries, pg := paginate(entries, param.Page, param.Limit)
+		return entries, pg, nil
+	}
+
+	entries, pg, err := l.leaderboard.GetList(ctx, key, param.Page, param.Limit)
+	if err != nil {
+		return []entity.LeaderboardEntry{}, &entity.Pagination{}, err
+	}
+
+	if err := l.populateUsers(ctx, entries); err != nil {
+		return []entity.LeaderboardEntry{}, &entity.Pagination{}, err
+	}
+
+	return entries, pg, nil
+}
+
+// GetMe return the rank of the user on the leaderboard, the rank is zero when the user is not on it
+func (l *leaderboard) GetMe(ctx context.Context, param entity.LeaderboardParam) (entity.LeaderboardEntry, error) {
+	user, err := l.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.LeaderboardEntry{}, err
+	}
+
+	key, err := l.key(param)
+	if err != nil {
+		return entity.LeaderboardEntry{}, err
+	}
+
+	if param.Scope == entity.LeaderboardScopeWorkspace {
+		entries, err := l.getWorkspaceEntries(ctx, key, param.WorkspaceID)
+		if err != nil {
+			return entity.LeaderboardEntry{}, err
+		}
+
+		for _, entry := range entries {
+			if entry.UserID == user.User.ID {
+				return entry, nil
+			}
+		}
+
+		return entity.LeaderboardEntry{UserID: user.User.ID, Username: user.User.Username}, nil
+	}
+
+	entry, err := l.leaderboard.GetRank(ctx, key, user.User.ID)
+	if err != nil {
+		return entry, err
+	}
+
+	entry.Username = user.User.Username
+	if u, err := l.user.Get(ctx, entity.UserParam{ID: null.Int64From(user.User.ID)}); err == nil {
+		entry.DisplayName = u.DisplayName
+	}
+
+	return entry, nil
+}
+
+func (l *leaderboard) GetSettings(ctx context.Context) (entity.LeaderboardSettings, error) {
+	user, err := l.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.LeaderboardSettings{}, err
+	}
+
+	u, err := l.user.Get(ctx, entity.UserParam{ID: null.Int64From(user.User.ID)})
+	if err != nil {
+		return entity.LeaderboardSettings{}, err
+	}
+
+	return entity.LeaderboardSettings{OptOut: u.LeaderboardOptOut}, nil
+}
+
+// UpdateSettings save the privacy setting of the user. Opting out remove the user from the leaderboards right away,
+// opting in again put back the all time scores from the progress, the weekly scores start from the next done task
+func (l *leaderboard) UpdateSettings(ctx context.Context, settings entity.LeaderboardSettings) (entity.LeaderboardSettings, error) {
+	user, err := l.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return settings, err
+	}
+
+	if err := l.user.Update(ctx, entity.UpdateUserParam{
+		LeaderboardOptOut: null.BoolFrom(settings.OptOut),
+		UpdatedAt:         null.TimeFrom(Now()),
+		UpdatedBy:         null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}, entity.UserParam{ID: null.Int64From(user.User.ID)}); err != nil {
+		return settings, err
+	}
+
+	if settings.OptOut {
+		for _, key := range l.userKeys(user.User.ID) {
+			if err := l.leaderboard.Remove(ctx, key, user.User.ID); err != nil {
+				return settings, err
+			}
+		}
+
+		return settings, nil
+	}
+
+	progress, err := l.userProgress.Get(ctx, entity.UserProgressParam{UserID: null.Int64From(user.User.ID)})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return settings, nil
+	} else if err != nil {
+		return settings, err
+	}
+
+	scores := map[string]int64{
+		entity.LeaderboardMetricXP:    progress.XP,
+		entity.LeaderboardMetricTasks: progress.CompletedTasks,
+	}
+
+	for _, key := range l.userKeys(user.User.ID) {
+		if key.Period != entity.LeaderboardPeriodAllTime {
+			continue
+		}
+		if err := l.leaderboard.Set(ctx, key, user.User.ID, scores[key.Metric]); err != nil {
+			return settings, err
+		}
+	}
+
+	return settings, nil
+}
+
+// key validate the param and return the key of its leaderboard
+func (l *leaderboard) key(param entity.LeaderboardParam) (entity.LeaderboardKey, error) {
+	key := entity.LeaderboardKey{
+		Scope:  param.Scope,
+		Metric: param.Metric,
+		Period: param.Period,
+		Week:   Now().In(l.location),
+	}
+
+	if key.Scope == "" {
+		key.Scope = entity.LeaderboardScopeGlobal
+	}
+	if key.Metric == "" {
+		key.Metric = entity.LeaderboardMetricXP
+	}
+	if key.Period == "" {
+		key.Period = entity.LeaderboardPeriodWeekly
+	}
+
+	if key.Scope == entity.LeaderboardScopeWorkspace {
+		if param.WorkspaceID < 1 {
+			return key, errors.NewWithCode(codes.CodeBadRequest, "workspaceId is required by the workspace scope")
+		}
+		// The workspace leaderboard is the global one filtered by the members, so joining or leaving needs no update
+		key.Scope = entity.LeaderboardScopeGlobal
+	} else if key.Scope != entity.LeaderboardScopeGlobal {
+		return key, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid scope %q, must be one of global, workspace", param.Scope))
+	}
+	if key.Metric != entity.LeaderboardMetricXP && key.Metric != entity.LeaderboardMetricTasks {
+		return key, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid metric %q, must be one of xp, tasks", param.Metric))
+	}
+	if key.Period != entity.LeaderboardPeriodWeekly && key.Period != entity.LeaderboardPeriodAllTime {
+		return key, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid period %q, must be one of weekly, alltime", param.Period))
+	}
+
+	return key, nil
+}
+
+// userKeys return the keys of every current leaderboard the user is ranked on
+func (l *leaderboard) userKeys(userID int64) []entity.LeaderboardKey {
+	week := Now().In(l.location)
+
+	keys := []entity.LeaderboardKey{}
+	for _, metric := range []string{entity.LeaderboardMetricXP, entity.LeaderboardMetricTasks} {
+		for _, period := range []string{entity.LeaderboardPeriodWeekly, entity.LeaderboardPeriodAllTime} {
+			keys = append(keys, entity.LeaderboardKey{Scope: entity.LeaderboardScopeGlobal, Metric: metric, Period: period, Week: week})
+		}
+	}
+
+	return keys
+}
+
+// getWorkspaceEntries rank the members of the workspace by their score on the leaderboard, the member who is not on
+// the leaderboard is left out
+func (l *leaderboard) getWorkspaceEntries(ctx context.Context, key entity.LeaderboardKey, workspaceID int64) ([]entity.LeaderboardEntry, error) {
+	members, _, err := l.workspace.GetMembers(ctx, entity.WorkspaceMemberParam{
+		WorkspaceID: null.Int64From(workspaceID),
+		QueryOption: query.Option{
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := []int64{}
+	for _, member := range members {
+		userIDs = append(userIDs, member.UserID)
+	}
+
+	scores, err := l.leaderboard.GetScores(ctx, key, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []entity.LeaderboardEntry{}
+	for _, member := range members {
+		score, ok := scores[member.UserID]
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, entity.LeaderboardEntry{
+			UserID:      member.UserID,
+			Username:    member.Username,
+			DisplayName: member.DisplayName,
+			Score:       score,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].UserID < entries[j].UserID
+	})
+
+	for i := range entries {
+		entries[i].Rank = int64(i) + 1
+	}
+
+	return entries, nil
+}
+
+// paginate return the page of the ranked entries
+func paginate(entries []entity.LeaderboardEntry, page, limit int64) ([]entity.LeaderboardEntry, *entity.Pagination) {
+	if page < 1 {
+		page = 1
+	}
+
+	start, end := (page-1)*limit, page*limit
+	total := int64(len(entries))
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+
+	pg := entity.Pagination{
+		CurrentPage:     page,
+		CurrentElements: end - start,
+		TotalElements:   total,
+	}
+	pg.ProcessPagination(limit)
+
+	return entries[start:end], &pg
+}
+
+// populateUsers fill the names of the users on the leaderboard
+func (l *leaderboard) populateUsers(ctx context.Context, entries []entity.LeaderboardEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	userIDs := []int64{}
+	for _, entry := range entries {
+		userIDs = append(userIDs, entry.UserID)
+	}
+
+	users, _, err := l.user.GetList(ctx, entity.UserParam{
+		IDs: userIDs,
+		QueryOption: query.Option{
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	userMap := map[int64]entity.User{}
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	for i := range entries {
+		user := userMap[entries[i].UserID]
+		entries[i].Username = user.Username
+		entries[i].DisplayName = user.DisplayName
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package progress
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	userProgressDom "github.com/adiatma85/gg-project/src/business/domain/userprogress"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	leaderboardUc "github.com/adiatma85/gg-project/src/business/usecase/leaderboard"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+)
+
+type Interface interface {
+	Award(ctx context.Context, task entity.Task, userID int64) (entity.UserProgress, error)
+	Get(ctx context.Context, userID int64) (entity.UserProgress, error)
+	GetSelf(ctx context.Context) (entity.UserProgress, error)
+}
+
+type Config struct {
+	BaseXP             int64  // XP of every completed task
+	PriorityXP         int64  // Extra XP per priority point
+	MaxPriority        int64  // Priority above it does not give more XP
+	OnTimeBonusPercent int64  // Extra XP in percent when the task is done before its due time
+	Timezone           string // IANA time zone of the streak days, default is UTC
+}
+
+type InitParam struct {
+	Log          log.Interface
+	UserProgress userProgressDom.Interface
+	Leaderboard  leaderboardUc.Interface
+	JwtAuth      jwtAuth.Interface
+	Conf         Config
+}
+
+type progress struct {
+	log          log.Interface
+	userProgress userProgressDom.Interface
+	leaderboard  leaderboardUc.Interface
+	jwtAuth      jwtAuth.Interface
+	conf         Config
+	location     *time.Location
+}
+
+var Now = time.Now
+
+func Init(param InitParam) Interface {
+	p := &progress{
+		log:          param.Log,
+		userProgress: param.UserProgress,
+		leaderboard:  param.Leaderboard,
+		jwtAuth:      param.JwtAuth,
+		conf:         param.Conf,
+		location:     time.UTC,
+	}
+
+	if param.Conf.Timezone != "" {
+		loc, err := time.LoadLocation(param.Conf.Timezone)
+		if err != nil {
+			p.log.Warn(context.Background(), fmt.Sprintf("invalid progress timezone %q, UTC is used: %v", param.Conf.Timezone, err))
+		} else {
+			p.location = loc
+		}
+	}
+
+	return p
+}
+
+// Award give the XP of the done task to the user, weighted by the priority of the task and whether it was done before
+// its due time. The task is only awarded once, so reopening and finishing it again does not give more XP
+func (p *progress) Award(ctx context.Context, task entity.Task, userID int64) (entity.UserProgress, error) {
+	now := Now()
+	xp, onTime := p.taskXP(task, now)
+
+	result, isAwarded, err := p.userProgress.Award(ctx, entity.CreateXPAwardParam{
+		UserID:    userID,
+		TaskID:    task.ID,
+		XP:        xp,
+		OnTime:    onTime,
+		Day:       now.In(p.location),
+		CreatedBy: null.StringFrom(fmt.Sprintf("%v", userID)),
+		UpdatedBy: null.StringFrom(fmt.Sprintf("%v", userID)),
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if isAwarded {
+		p.log.Debug(ctx, fmt.Sprintf("awarded %d xp to user %d for task %d", xp, userID, task.ID))
+
+		// The leaderboards are not the source of the progress, failing to update them does not fail the award
+		if err := p.leaderboard.Record(ctx, userID, xp); err != nil {
+			p.log.Error(ctx, fmt.Sprintf("failed to record task %d of user %d on the leaderboards: %v", task.ID, userID, err))
+		}
+	}
+
+	result.ProcessStreak(now.In(p.location))
+
+	return result, nil
+}
+
+// taskXP return the XP of the task done at the time and whether it was done before its due time
+func (p *progress) taskXP(task entity.Task, doneAt time.Time) (int64, bool) {
+	priority := task.Priority
+	if priority < 0 {
+		priority = 0
+	}
+	if p.conf.MaxPriority > 0 && priority > p.conf.MaxPriority {
+		priority = p.conf.MaxPriority
+	}
+
+	xp := p.conf.BaseXP + p.conf.PriorityXP*priority
+
+	onTime := task.DueTime.Valid && !doneAt.After(task.DueTime.Time)
+	if onTime {
+		xp += xp * p.conf.OnTimeBonusPercent / 100
+	}
+
+	return xp, onTime
+}
+
+// Get return the progress of the user, user who has not completed any task is at the first level
+func (p *progress) Get(ctx context.Context, userID int64) (entity.UserProgress, error) {
+	result, err := p.userProgress.Get(ctx, entity.UserProgressParam{UserID: null.Int64From(userID)})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		result = entity.UserProgress{UserID: userID}
+		result.ProcessLevel()
+	} else if err != nil {
+		return result, err
+	}
+
+	result.ProcessStreak(Now().In(p.location))
+
+	return result, nil
+}
+
+func (p *progress) GetSelf(ctx context.Context) (entity.UserProgress, error) {
+	user, err := p.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.UserProgress{}, err
+	}
+
+	return p.Get(ctx, user.User.ID)
+}
@@ -0,0 +1,292 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/domain/notifier"
+	taskDom "github.com/adiatma85/gg-project/src/business/domain/task"
+	taskReminderDom "github.com/adiatma85/gg-project/src/business/domain/taskreminder"
+	userDom "github.com/adiatma85/gg-project/src/business/domain/user"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/google/uuid"
+)
+
+type Interface interface {
+	Create(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTaskReminderParam) (entity.TaskReminder, error)
+	GetList(ctx context.Context, params entity.TaskReminderParam) ([]entity.TaskReminder, *entity.Pagination, error)
+	Delete(ctx context.Context, selectParam entity.TaskReminderParam) error
+	Dispatch(ctx context.Context) (int, error)
+}
+
+type Config struct {
+	BatchSize    int64         // Maximum number of reminders claimed in one dispatch
+	ClaimTimeout time.Duration // Claim older than this is taken over by the next dispatch
+	MaxAttempts  int64         // Reminder is given up after this many failed attempts
+}
+
+type InitParam struct {
+	Log          log.Interface
+	TaskReminder taskReminderDom.Interface
+	TaskDom      taskDom.Interface
+	User         userDom.Interface
+	Notifier     notifier.Interface
+	Task         taskUc.Interface
+	JwtAuth      jwtAuth.Interface
+	Conf         Config
+}
+
+type reminder struct {
+	log          log.Interface
+	taskReminder taskReminderDom.Interface
+	taskDom      taskDom.Interface
+	user         userDom.Interface
+	notifier     notifier.Interface
+	task         taskUc.Interface
+	jwtAuth      jwtAuth.Interface
+	conf         Config
+}
+
+var Now = time.Now
+
+// Longest offset of before kind, reminder further than this from the due time is not useful
+const maxOffsetMinutes = 30 * 24 * 60
+
+// Last error is stored in a VARCHAR(255) column
+const maxLastErrorLength = 255
+
+func Init(param InitParam) Interface {
+	if param.Conf.BatchSize < 1 {
+		param.Conf.BatchSize = 100
+	}
+
+	if param.Conf.ClaimTimeout == 0 {
+		param.Conf.ClaimTimeout = 5 * time.Minute
+	}
+
+	if param.Conf.MaxAttempts < 1 {
+		param.Conf.MaxAttempts = 5
+	}
+
+	r := &reminder{
+		log:          param.Log,
+		taskReminder: param.TaskReminder,
+		taskDom:      param.TaskDom,
+		user:         param.User,
+		notifier:     param.Notifier,
+		task:         param.Task,
+		jwtAuth:      param.JwtAuth,
+		conf:         param.Conf,
+	}
+
+	return r
+}
+
+func validateReminder(req entity.CreateTaskReminderParam) error {
+	switch req.Kind {
+	case entity.TaskReminderKindBefore:
+		if req.OffsetMinutes < 0 || req.OffsetMinutes > maxOffsetMinutes {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("offsetMinutes must be between 0 and %d", maxOffsetMinutes))
+		}
+	case entity.TaskReminderKindAt:
+		if _, err := time.Parse(entity.TaskReminderTimeOfDayFormat, req.TimeOfDay); err != nil {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid timeOfDay %q, use HH:MM", req.TimeOfDay))
+		}
+
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid timezone %q", req.Timezone))
+		}
+	default:
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid reminder kind %q", req.Kind))
+	}
+
+	return nil
+}
+
+func (r *reminder) Create(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTaskReminderParam) (entity.TaskReminder, error) {
+	user, err := r.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TaskReminder{}, err
+	}
+
+	if err := validateReminder(req); err != nil {
+		return entity.TaskReminder{}, err
+	}
+
+	if req.Kind == entity.TaskReminderKindBefore {
+		req.TimeOfDay = ""
+		req.Timezone = ""
+	} else {
+		req.OffsetMinutes = 0
+	}
+
+	if req.Timezone == "" {
+		req.Timezone = time.UTC.String()
+	}
+
+	// Make sure the task is accessible by the user
+	task, err := r.task.Get(ctx, entity.TaskParam{ID: taskParam.ID})
+	if err != nil {
+		return entity.TaskReminder{}, err
+	}
+
+	if !task.DueTime.Valid {
+		return entity.TaskReminder{}, errors.NewWithCode(codes.CodeBadRequest, "task has no due time")
+	}
+
+	req.TaskID = task.ID
+	req.UserID = user.User.ID
+	req.RemindAt = null.TimeFrom(entity.ScheduleTaskReminder(req.Kind, req.OffsetMinutes, req.TimeOfDay, req.Timezone, task.DueTime.Time))
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return r.taskReminder.Create(ctx, req)
+}
+
+func (r *reminder) GetList(ctx context.Context, params entity.TaskReminderParam) ([]entity.TaskReminder, *entity.Pagination, error) {
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	// Make sure the task is accessible by the user
+	task, err := r.task.Get(ctx, entity.TaskParam{ID: params.TaskID})
+	if err != nil {
+		return []entity.TaskReminder{}, &entity.Pagination{}, err
+	}
+
+	params.TaskID = null.Int64From(task.ID)
+	params.SortBy = []string{"remind_at"}
+
+	return r.taskReminder.GetList(ctx, params)
+}
+
+func (r *reminder) Delete(ctx context.Context, selectParam entity.TaskReminderParam) error {
+	user, err := r.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Make sure the task is accessible by the user
+	task, err := r.task.Get(ctx, entity.TaskParam{ID: selectParam.TaskID})
+	if err != nil {
+		return err
+	}
+
+	reminder, err := r.taskReminder.Get(ctx, entity.TaskReminderParam{
+		ID:     selectParam.ID,
+		TaskID: null.Int64From(task.ID),
+		Status: null.Int64From(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	deleteParam := entity.UpdateTaskReminderParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return r.taskReminder.Update(ctx, deleteParam, entity.TaskReminderParam{ID: null.Int64From(reminder.ID)})
+}
+
+// Dispatch send the reminders which are due and return the number of sent reminders. Each dispatch claims
+// the reminders with its own token first, so running it from several workers or after a restart does not
+// send the same reminder twice
+func (r *reminder) Dispatch(ctx context.Context) (int, error) {
+	claimToken := uuid.New().String()
+
+	reminders, err := r.taskReminder.Claim(ctx, entity.ClaimTaskReminderParam{
+		ClaimToken:   claimToken,
+		Now:          Now(),
+		ClaimTimeout: r.conf.ClaimTimeout,
+		MaxAttempts:  r.conf.MaxAttempts,
+		Limit:        r.conf.BatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, reminder := range reminders {
+		updateParam := entity.UpdateTaskReminderParam{
+			ClaimToken: null.StringFrom(""),
+			UpdatedAt:  null.TimeFrom(Now()),
+			UpdatedBy:  null.StringFrom(fmt.Sprintf("%v", entity.SchedulerUser)),
+		}
+
+		skipped, err := r.send(ctx, reminder)
+		if err != nil {
+			// Release the claim so the next dispatch can retry it
+			r.log.Error(ctx, fmt.Sprintf("failed to send reminder %d: %v", reminder.ID, err))
+			updateParam.LastError = null.StringFrom(truncate(err.Error(), maxLastErrorLength))
+		} else {
+			updateParam.SentAt = null.TimeFrom(Now())
+			updateParam.LastError = null.StringFrom(skipped)
+			if skipped == "" {
+				sent++
+			}
+		}
+
+		if err := r.taskReminder.Update(ctx, updateParam, entity.TaskReminderParam{ID: null.Int64From(reminder.ID), ClaimToken: claimToken}); err != nil {
+			return sent, err
+		}
+	}
+
+	return sent, nil
+}
+
+// send deliver the reminder to its recipient, a reminder of a finished or deleted task is skipped with the reason
+func (r *reminder) send(ctx context.Context, reminder entity.TaskReminder) (string, error) {
+	task, err := r.taskDom.Get(ctx, entity.TaskParam{
+		ID: null.Int64From(reminder.TaskID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return "skipped: task is deleted", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	if task.TaskStatus == entity.TaskStatusDone {
+		return "skipped: task is done", nil
+	}
+
+	user, err := r.user.Get(ctx, entity.UserParam{ID: null.Int64From(reminder.UserID)})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return "skipped: recipient does not exist", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	body := []string{
+		fmt.Sprintf("Your task %q is due at %s.", task.Title, task.DueTime.Time.Format(time.RFC1123)),
+	}
+	if task.Description != "" {
+		body = append(body, "", task.Description)
+	}
+
+	return "", r.notifier.Send(ctx, notifier.Message{
+		To:      []string{user.Email},
+		Subject: fmt.Sprintf("Reminder: %s", task.Title),
+		Body:    strings.Join(body, "\n"),
+	})
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return s[:n]
+}
@@ -0,0 +1,96 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	searchDom "github.com/adiatma85/gg-project/src/business/domain/search"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	workspaceUc "github.com/adiatma85/gg-project/src/business/usecase/workspace"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+)
+
+type Interface interface {
+	Search(ctx context.Context, params entity.SearchParam) ([]entity.SearchResult, *entity.Pagination, error)
+}
+
+type InitParam struct {
+	Log       log.Interface
+	Search    searchDom.Interface
+	Workspace workspaceUc.Interface
+	JwtAuth   jwtAuth.Interface
+}
+
+type search struct {
+	log       log.Interface
+	search    searchDom.Interface
+	workspace workspaceUc.Interface
+	jwtAuth   jwtAuth.Interface
+}
+
+const (
+	maxQueryLength   = 255
+	maxSnippetLength = 200
+)
+
+func Init(param InitParam) Interface {
+	s := &search{
+		log:       param.Log,
+		search:    param.Search,
+		workspace: param.Workspace,
+		jwtAuth:   param.JwtAuth,
+	}
+
+	return s
+}
+
+func (s *search) Search(ctx context.Context, params entity.SearchParam) ([]entity.SearchResult, *entity.Pagination, error) {
+	user, err := s.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return []entity.SearchResult{}, &entity.Pagination{}, err
+	}
+
+	params.Query = strings.TrimSpace(params.Query)
+	if params.Query == "" {
+		return []entity.SearchResult{}, &entity.Pagination{}, errors.NewWithCode(codes.CodeBadRequest, "search query can not be empty")
+	} else if utf8.RuneCountInString(params.Query) > maxQueryLength {
+		return []entity.SearchResult{}, &entity.Pagination{}, errors.NewWithCode(codes.CodeBadRequest, "search query is too long")
+	}
+
+	// If the user is not admin, then search only the data the user can access, the same as the task list
+	params.AccessUserID, params.AccessWorkspaceIDs = null.Int64{}, nil
+	if !entity.IsSuperAdmin(ctx, user.User.ID) {
+		workspaceIDs, err := s.workspace.GetWorkspaceIDs(ctx, user.User.ID)
+		if err != nil {
+			return []entity.SearchResult{}, &entity.Pagination{}, err
+		}
+
+		params.AccessUserID = null.Int64From(user.User.ID)
+		params.AccessWorkspaceIDs = workspaceIDs
+	}
+
+	results, pg, err := s.search.Search(ctx, params)
+	if err != nil {
+		return results, pg, err
+	}
+
+	for i := range results {
+		results[i].Snippet = truncate(results[i].Snippet, maxSnippetLength)
+	}
+
+	return results, pg, nil
+}
+
+// truncate cut the text to the maximum number of characters
+func truncate(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+
+	return string([]rune(text)[:max]) + "..."
+}
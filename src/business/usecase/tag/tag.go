@@ -0,0 +1,249 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tagDom "github.com/adiatma85/gg-project/src/business/domain/tag"
+	taskTagDom "github.com/adiatma85/gg-project/src/business/domain/tasktag"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type Interface interface {
+	Create(ctx context.Context, req entity.CreateTagParam) (entity.Tag, error)
+	Get(ctx context.Context, params entity.TagParam) (entity.Tag, error)
+	GetList(ctx context.Context, params entity.TagParam) ([]entity.Tag, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateTagParam, selectParam entity.TagParam) error
+	Delete(ctx context.Context, selectParam entity.TagParam) error
+	AddToTask(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTaskTagParam) (entity.TaskTag, error)
+	RemoveFromTask(ctx context.Context, selectParam entity.TaskTagParam) error
+}
+
+type InitParam struct {
+	Log     log.Interface
+	Tag     tagDom.Interface
+	TaskTag taskTagDom.Interface
+	Task    taskUc.Interface
+	JwtAuth jwtAuth.Interface
+}
+
+type tag struct {
+	log     log.Interface
+	tag     tagDom.Interface
+	taskTag taskTagDom.Interface
+	task    taskUc.Interface
+	jwtAuth jwtAuth.Interface
+}
+
+var Now = time.Now
+
+func Init(param InitParam) Interface {
+	t := &tag{
+		log:     param.Log,
+		tag:     param.Tag,
+		taskTag: param.TaskTag,
+		task:    param.Task,
+		jwtAuth: param.JwtAuth,
+	}
+
+	return t
+}
+
+func (t *tag) Create(ctx context.Context, req entity.CreateTagParam) (entity.Tag, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Tag{}, err
+	}
+
+	req.Name, err = t.validateName(ctx, req.Name, user.User.ID, 0)
+	if err != nil {
+		return entity.Tag{}, err
+	}
+
+	req.UserID = user.User.ID
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return t.tag.Create(ctx, req)
+}
+
+func (t *tag) Get(ctx context.Context, params entity.TagParam) (entity.Tag, error) {
+	params.QueryOption.IsActive = true
+
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Tag{}, err
+	}
+
+	// If the user id is not admin, then filter for that user
+	if !entity.IsSuperAdmin(ctx, user.User.ID) {
+		params.UserID = null.Int64From(user.User.ID)
+	}
+
+	return t.tag.Get(ctx, params)
+}
+
+func (t *tag) GetList(ctx context.Context, params entity.TagParam) ([]entity.Tag, *entity.Pagination, error) {
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return []entity.Tag{}, &entity.Pagination{}, err
+	}
+
+	// If the user id is not admin, then filter for that user
+	if !entity.IsSuperAdmin(ctx, user.User.ID) {
+		params.UserID = null.Int64From(user.User.ID)
+	}
+
+	return t.tag.GetList(ctx, params)
+}
+
+func (t *tag) Update(ctx context.Context, updateParam entity.UpdateTagParam, selectParam entity.TagParam) error {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	tag, err := t.Get(ctx, selectParam)
+	if err != nil {
+		return err
+	}
+
+	updateParam.Name, err = t.validateName(ctx, updateParam.Name, tag.UserID, tag.ID)
+	if err != nil {
+		return err
+	}
+
+	updateParam.UpdatedAt = null.TimeFrom(Now())
+	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return t.tag.Update(ctx, updateParam, entity.TagParam{ID: null.Int64From(tag.ID)})
+}
+
+func (t *tag) Delete(ctx context.Context, selectParam entity.TagParam) error {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	tag, err := t.Get(ctx, selectParam)
+	if err != nil {
+		return err
+	}
+
+	deleteParam := entity.UpdateTagParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return t.tag.Update(ctx, deleteParam, entity.TagParam{ID: null.Int64From(tag.ID)})
+}
+
+func (t *tag) AddToTask(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTaskTagParam) (entity.TaskTag, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TaskTag{}, err
+	}
+
+	task, err := t.task.Get(ctx, entity.TaskParam{ID: taskParam.ID})
+	if err != nil {
+		return entity.TaskTag{}, err
+	}
+
+	tag, err := t.Get(ctx, entity.TagParam{ID: null.Int64From(req.TagID)})
+	if err != nil {
+		return entity.TaskTag{}, err
+	}
+
+	// Tagging the same task twice is a no-op
+	taskTag, err := t.taskTag.Get(ctx, entity.TaskTagParam{
+		TaskID: null.Int64From(task.ID),
+		TagID:  null.Int64From(tag.ID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err == nil {
+		return taskTag, nil
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return entity.TaskTag{}, err
+	}
+
+	req.TaskID = task.ID
+	req.TagID = tag.ID
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return t.taskTag.Create(ctx, req)
+}
+
+func (t *tag) RemoveFromTask(ctx context.Context, selectParam entity.TaskTagParam) error {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	task, err := t.task.Get(ctx, entity.TaskParam{ID: selectParam.TaskID})
+	if err != nil {
+		return err
+	}
+
+	taskTag, err := t.taskTag.Get(ctx, entity.TaskTagParam{
+		TaskID: null.Int64From(task.ID),
+		TagID:  selectParam.TagID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	deleteParam := entity.UpdateTaskTagParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return t.taskTag.Update(ctx, deleteParam, entity.TaskTagParam{ID: null.Int64From(taskTag.ID)})
+}
+
+// validateName return the trimmed name if it is not empty and not used by another tag of the user
+func (t *tag) validateName(ctx context.Context, name string, userID, tagID int64) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.NewWithCode(codes.CodeBadRequest, "tag name can not be empty")
+	}
+
+	// The comma is used as the separator in the task filter
+	if strings.ContainsAny(name, ",:") {
+		return "", errors.NewWithCode(codes.CodeBadRequest, "tag name can not contain comma or colon")
+	}
+
+	existing, err := t.tag.Get(ctx, entity.TagParam{
+		UserID: null.Int64From(userID),
+		Name:   null.StringFrom(name),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err == nil && existing.ID != tagID {
+		return "", errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("tag %q already exists", name))
+	} else if err != nil && errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return "", err
+	}
+
+	return name, nil
+}
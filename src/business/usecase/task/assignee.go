@@ -0,0 +1,112 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// Assign the task to another user, only the owner can assign or reassign the task
+func (t *task) Assign(ctx context.Context, selectParam entity.TaskParam, req entity.AssignTaskParam) (entity.Task, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	task, err := t.Get(ctx, selectParam)
+	if err != nil {
+		return task, err
+	}
+
+	if canManage, err := t.canManageTask(ctx, task, user.User.ID); err != nil {
+		return task, err
+	} else if !canManage {
+		return task, errors.NewWithCode(codes.CodeForbidden, "only the owner can assign the task")
+	}
+
+	if err := t.validateAssignee(ctx, req.AssigneeID, task.WorkspaceID); err != nil {
+		return task, err
+	}
+
+	if err := t.task.UpdateAssignee(ctx, task.ID, null.Int64From(req.AssigneeID), null.StringFrom(fmt.Sprintf("%v", user.User.ID))); err != nil {
+		return task, err
+	}
+
+	task.AssigneeID = null.Int64From(req.AssigneeID)
+
+	return task, nil
+}
+
+// Unassign the task, the owner can take the task back and the assignee can give it back
+func (t *task) Unassign(ctx context.Context, selectParam entity.TaskParam) (entity.Task, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	task, err := t.Get(ctx, selectParam)
+	if err != nil {
+		return task, err
+	}
+
+	if !task.AssigneeID.Valid {
+		return task, nil
+	}
+
+	if task.AssigneeID.Int64 != user.User.ID {
+		if canManage, err := t.canManageTask(ctx, task, user.User.ID); err != nil {
+			return task, err
+		} else if !canManage {
+			return task, errors.NewWithCode(codes.CodeForbidden, "only the owner or the assignee can unassign the task")
+		}
+	}
+
+	if err := t.task.UpdateAssignee(ctx, task.ID, null.Int64{}, null.StringFrom(fmt.Sprintf("%v", user.User.ID))); err != nil {
+		return task, err
+	}
+
+	task.AssigneeID = null.Int64{}
+
+	return task, nil
+}
+
+// validateAssignee make sure the task is assigned to an active user, the task of a workspace can only be assigned
+// to its members
+func (t *task) validateAssignee(ctx context.Context, assigneeID int64, workspaceID null.Int64) error {
+	if assigneeID < 1 {
+		return errors.NewWithCode(codes.CodeBadRequest, "assigneeId is required")
+	}
+
+	_, err := t.user.Get(ctx, entity.UserParam{
+		ID: null.Int64From(assigneeID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("user %d does not exist", assigneeID))
+	} else if err != nil {
+		return err
+	}
+
+	if workspaceID.Valid {
+		_, err := t.workspace.Authorize(ctx, workspaceID.Int64, assigneeID)
+		if errors.GetCode(err) == codes.CodeForbidden {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("user %d is not a member of the workspace", assigneeID))
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isTaskOwner return true when the user owns the task, admin is treated as the owner of every task
+func isTaskOwner(ctx context.Context, task entity.Task, userID int64) bool {
+	return task.UserId == userID || entity.IsSuperAdmin(ctx, userID)
+}
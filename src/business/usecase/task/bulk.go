@@ -0,0 +1,208 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// Maximum number of task updated in one bulk request
+const maxBulkItems = 500
+
+// bulkStatusChange is a status change which side effects run after the bulk transaction is committed
+type bulkStatusChange struct {
+	task   entity.Task
+	to     string
+	result int // Index of the result of the change
+}
+
+func validateBulkOperation(op entity.BulkTaskOperation) error {
+	if len(op.IDs) < 1 {
+		return errors.NewWithCode(codes.CodeBadRequest, "ids can not be empty")
+	}
+
+	switch op.Action {
+	case entity.TaskBulkActionStatus:
+		if !isValidStatus(op.TaskStatus) {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid task status %q", op.TaskStatus))
+		}
+	case entity.TaskBulkActionCategory:
+		if op.CategoryID < 1 {
+			return errors.NewWithCode(codes.CodeBadRequest, "categoryId is required")
+		}
+	case entity.TaskBulkActionPriority:
+		if op.Priority < 1 {
+			return errors.NewWithCode(codes.CodeBadRequest, "priority must be greater than zero")
+		}
+	case entity.TaskBulkActionDelete:
+	default:
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid action %q", op.Action))
+	}
+
+	return nil
+}
+
+// Bulk apply the operations in order inside one transaction which locks the tasks, so the tasks are validated against
+// the state they are updated from. Task which can not be updated, e.g. not found or blocked, is reported in its result
+// and skipped without failing the others
+func (t *task) Bulk(ctx context.Context, param entity.BulkTaskParam) ([]entity.BulkTaskResult, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for i, op := range param.Operations {
+		if err := validateBulkOperation(op); err != nil {
+			return nil, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("operation %d: %v", i, err))
+		}
+		ids = append(ids, op.IDs...)
+	}
+
+	if len(ids) < 1 {
+		return nil, errors.NewWithCode(codes.CodeBadRequest, "operations can not be empty")
+	} else if len(ids) > maxBulkItems {
+		return nil, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("at most %d tasks can be updated at once", maxBulkItems))
+	}
+
+	// Lock all of the tasks at once, non admin user can only update the tasks they can access
+	taskParam := entity.TaskParam{
+		IDs: ids,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}
+	if err := t.applyAccessFilter(ctx, &taskParam, user.User.ID); err != nil {
+		return nil, err
+	}
+
+	results := []entity.BulkTaskResult{}
+	statusChanges := []bulkStatusChange{}
+
+	err = t.task.UpdateBulkLocked(ctx, taskParam, func(tasks []entity.Task) ([]entity.TaskBulkUpdate, []entity.CreateTaskStatusHistoryParam, error) {
+		return t.applyBulkOperations(ctx, param.Operations, tasks, user.User.ID, &results, &statusChanges)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Side effects are the same as the single update and run once the updates are committed. The change is already
+	// saved, so the side effect which fails is reported on the result of its task instead of failing the request
+	for _, change := range statusChanges {
+		if err := t.onStatusChanged(ctx, change.task, change.to, user.User.ID); err != nil {
+			t.log.Error(ctx, fmt.Sprintf("side effect of the status change of task %d failed: %v", change.task.ID, err))
+			results[change.result].EffectError = err.Error()
+		}
+	}
+
+	return results, nil
+}
+
+// applyBulkOperations validate the operations against the locked tasks and return the updates and the status history,
+// the result of every task and the status changes which side effects run after the commit are appended to the slices
+func (t *task) applyBulkOperations(ctx context.Context, operations []entity.BulkTaskOperation, tasks []entity.Task, userID int64, results *[]entity.BulkTaskResult, statusChanges *[]bulkStatusChange) ([]entity.TaskBulkUpdate, []entity.CreateTaskStatusHistoryParam, error) {
+	taskMap := map[int64]entity.Task{}
+	for _, task := range tasks {
+		taskMap[task.ID] = task
+	}
+
+	updates := []entity.TaskBulkUpdate{}
+	histories := []entity.CreateTaskStatusHistoryParam{}
+	updatedBy := null.StringFrom(fmt.Sprintf("%v", userID))
+
+	for i, op := range operations {
+		for _, id := range op.IDs {
+			result := entity.BulkTaskResult{
+				Operation: i,
+				TaskID:    id,
+			}
+
+			// The map hold the state of the task after the previous operations in this request
+			task, ok := taskMap[id]
+			if !ok {
+				result.Error = fmt.Sprintf("task %d not found", id)
+				*results = append(*results, result)
+				continue
+			}
+
+			// Assignee can change the status and the priority, the category and the task itself belong to the owner
+			isOwnerAction := op.Action == entity.TaskBulkActionCategory || op.Action == entity.TaskBulkActionDelete
+			if isOwnerAction {
+				canManage, err := t.canManageTask(ctx, task, userID)
+				if err != nil {
+					return nil, nil, err
+				} else if !canManage {
+					result.Error = fmt.Sprintf("task %d can only be changed by its owner", id)
+					*results = append(*results, result)
+					continue
+				}
+			}
+
+			if op.Action == entity.TaskBulkActionCategory {
+				err := t.validateCategory(ctx, op.CategoryID, task.WorkspaceID)
+				if errors.GetCode(err) == codes.CodeBadRequest {
+					result.Error = err.Error()
+					*results = append(*results, result)
+					continue
+				} else if err != nil {
+					return nil, nil, err
+				}
+			}
+
+			updateParam := entity.UpdateTaskParam{
+				UpdatedAt: null.TimeFrom(Now()),
+				UpdatedBy: updatedBy,
+			}
+
+			switch op.Action {
+			case entity.TaskBulkActionStatus:
+				err := t.validateStatusChange(ctx, task, op.TaskStatus)
+				if code := errors.GetCode(err); code == codes.CodeBadRequest || code == codes.CodeConflict {
+					result.Error = err.Error()
+					*results = append(*results, result)
+					continue
+				} else if err != nil {
+					return nil, nil, err
+				}
+
+				updateParam.TaskStatus = op.TaskStatus
+				if op.TaskStatus != task.TaskStatus {
+					histories = append(histories, statusHistory(task.ID, task.TaskStatus, op.TaskStatus, userID))
+					*statusChanges = append(*statusChanges, bulkStatusChange{task: task, to: op.TaskStatus, result: len(*results)})
+				}
+				task.TaskStatus = op.TaskStatus
+			case entity.TaskBulkActionCategory:
+				updateParam.CategoryID = null.Int64From(op.CategoryID)
+				task.CategoryID = null.Int64From(op.CategoryID)
+			case entity.TaskBulkActionPriority:
+				updateParam.Priority = op.Priority
+				task.Priority = op.Priority
+			case entity.TaskBulkActionDelete:
+				updateParam.Status = null.Int64From(-1)
+				updateParam.DeletedAt = null.TimeFrom(Now())
+				updateParam.DeletedBy = updatedBy
+			}
+
+			updates = append(updates, entity.TaskBulkUpdate{
+				UpdateParam: updateParam,
+				SelectParam: entity.TaskParam{ID: null.Int64From(task.ID)},
+			})
+
+			if op.Action == entity.TaskBulkActionDelete {
+				delete(taskMap, id)
+			} else {
+				taskMap[id] = task
+			}
+
+			result.Success = true
+			*results = append(*results, result)
+		}
+	}
+
+	return updates, histories, nil
+}
@@ -0,0 +1,94 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// GetCalendarEvents return the tasks of the user which are due inside the window. The caller is not read from the
+// context since the calendar feed is authenticated with its own token, so the user must be verified beforehand
+func (t *task) GetCalendarEvents(ctx context.Context, params entity.TaskCalendarParam) ([]entity.TaskCalendarEvent, error) {
+	result := []entity.TaskCalendarEvent{}
+
+	// Unfinished recurring task which is already overdue still has its next occurrences inside the window, it comes
+	// first since it is due before the window
+	tasks, _, err := t.task.GetList(ctx, entity.TaskParam{
+		UserId:        null.Int64From(params.UserID),
+		DueTimeLT:     null.TimeFrom(params.From),
+		RecurringOnly: true,
+		TaskStatusNE:  entity.TaskStatusDone,
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"due_time"},
+		},
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	dueTasks, _, err := t.task.GetList(ctx, entity.TaskParam{
+		UserId:     null.Int64From(params.UserID),
+		DueTimeGTE: null.TimeFrom(params.From),
+		DueTimeLTE: null.TimeFrom(params.To),
+		PaginationParam: entity.PaginationParam{
+			SortBy: []string{"due_time"},
+		},
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+	tasks = append(tasks, dueTasks...)
+
+	if err := t.populateTags(ctx, tasks); err != nil {
+		return result, err
+	}
+
+	for _, task := range tasks {
+		if !task.DueTime.Valid {
+			continue
+		}
+
+		if !task.DueTime.Time.Before(params.From) {
+			result = append(result, entity.TaskCalendarEvent{
+				Task:    task,
+				DueTime: task.DueTime.Time,
+			})
+		}
+
+		// Done task already spawned its next occurrence as a new task
+		if !isRecurring(task) || task.TaskStatus == entity.TaskStatusDone {
+			continue
+		}
+
+		from := task.DueTime.Time.Add(time.Second)
+		if from.Before(params.From) {
+			from = params.From
+		}
+
+		occurrences, err := t.occurrencesBetween(ctx, task, from, params.To)
+		if err != nil {
+			return result, err
+		}
+
+		for _, o := range occurrences {
+			result = append(result, entity.TaskCalendarEvent{
+				Task:       task,
+				DueTime:    o,
+				Occurrence: true,
+			})
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,192 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+func (t *task) GetBlockers(ctx context.Context, params entity.TaskParam) ([]entity.Task, error) {
+	task, err := t.Get(ctx, entity.TaskParam{ID: params.ID})
+	if err != nil {
+		return []entity.Task{}, err
+	}
+
+	return t.getBlockerTasks(ctx, task.ID)
+}
+
+func (t *task) AddBlocker(ctx context.Context, params entity.TaskParam, req entity.CreateTaskDependencyParam) (entity.TaskDependency, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TaskDependency{}, err
+	}
+
+	task, err := t.Get(ctx, entity.TaskParam{ID: params.ID})
+	if err != nil {
+		return entity.TaskDependency{}, err
+	}
+
+	if req.BlockerTaskID == task.ID {
+		return entity.TaskDependency{}, errors.NewWithCode(codes.CodeBadRequest, "task can not block itself")
+	}
+
+	// The blocker must be visible to the user as well
+	blocker, err := t.Get(ctx, entity.TaskParam{ID: null.Int64From(req.BlockerTaskID)})
+	if err != nil {
+		return entity.TaskDependency{}, err
+	}
+
+	_, err = t.taskDependency.Get(ctx, entity.TaskDependencyParam{
+		TaskID:        null.Int64From(task.ID),
+		BlockerTaskID: null.Int64From(blocker.ID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err == nil {
+		return entity.TaskDependency{}, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("task %d is already blocked by task %d", task.ID, blocker.ID))
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return entity.TaskDependency{}, err
+	}
+
+	isCyclic, err := t.isBlockedBy(ctx, blocker.ID, task.ID)
+	if err != nil {
+		return entity.TaskDependency{}, err
+	} else if isCyclic {
+		return entity.TaskDependency{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("task %d already depends on task %d, adding it as a blocker will create a cycle", blocker.ID, task.ID))
+	}
+
+	req.TaskID = task.ID
+	req.BlockerTaskID = blocker.ID
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return t.taskDependency.Create(ctx, req)
+}
+
+func (t *task) RemoveBlocker(ctx context.Context, params entity.TaskDependencyParam) error {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	task, err := t.Get(ctx, entity.TaskParam{ID: params.TaskID})
+	if err != nil {
+		return err
+	}
+
+	dependency, err := t.taskDependency.Get(ctx, entity.TaskDependencyParam{
+		TaskID:        null.Int64From(task.ID),
+		BlockerTaskID: params.BlockerTaskID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	deleteParam := entity.UpdateTaskDependencyParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return t.taskDependency.Update(ctx, deleteParam, entity.TaskDependencyParam{ID: null.Int64From(dependency.ID)})
+}
+
+// getBlockerTasks return the active tasks which block the given task
+func (t *task) getBlockerTasks(ctx context.Context, taskID int64) ([]entity.Task, error) {
+	dependencies, _, err := t.taskDependency.GetList(ctx, entity.TaskDependencyParam{
+		TaskID: null.Int64From(taskID),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return []entity.Task{}, err
+	}
+
+	if len(dependencies) < 1 {
+		return []entity.Task{}, nil
+	}
+
+	blockerIDs := []int64{}
+	for _, d := range dependencies {
+		blockerIDs = append(blockerIDs, d.BlockerTaskID)
+	}
+
+	blockers, _, err := t.task.GetList(ctx, entity.TaskParam{
+		IDs: blockerIDs,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return []entity.Task{}, err
+	}
+
+	return blockers, nil
+}
+
+// checkBlockers return conflict error if the task still has blockers which are not done yet
+func (t *task) checkBlockers(ctx context.Context, taskID int64) error {
+	blockers, err := t.getBlockerTasks(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	openBlockers := []string{}
+	for _, b := range blockers {
+		if b.TaskStatus != entity.TaskStatusDone {
+			openBlockers = append(openBlockers, fmt.Sprintf("%d", b.ID))
+		}
+	}
+
+	if len(openBlockers) > 0 {
+		return errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("task %d is blocked by unfinished task(s): %s", taskID, strings.Join(openBlockers, ", ")))
+	}
+
+	return nil
+}
+
+// isBlockedBy walk the blocker graph breadth first and return true if taskID is blocked by targetID, directly or transitively
+func (t *task) isBlockedBy(ctx context.Context, taskID, targetID int64) (bool, error) {
+	visited := map[int64]bool{taskID: true}
+	frontier := []int64{taskID}
+
+	for len(frontier) > 0 {
+		dependencies, _, err := t.taskDependency.GetList(ctx, entity.TaskDependencyParam{
+			TaskIDs: frontier,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		})
+		if err != nil {
+			return false, err
+		}
+
+		frontier = []int64{}
+		for _, d := range dependencies {
+			if d.BlockerTaskID == targetID {
+				return true, nil
+			}
+
+			if !visited[d.BlockerTaskID] {
+				visited[d.BlockerTaskID] = true
+				frontier = append(frontier, d.BlockerTaskID)
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,32 @@
+package task
+
+import (
+	"context"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+)
+
+// Export pass every task matching the list filters to fn together with its category name. The tasks are read
+// one by one so the whole list is never held in memory
+func (t *task) Export(ctx context.Context, params entity.TaskParam, fn func(task entity.Task, category string) error) error {
+	params.QueryOption.IsActive = true
+
+	isMatched, err := t.applyListFilter(ctx, &params)
+	if err != nil || !isMatched {
+		return err
+	}
+
+	categories, err := t.getCategories(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	categoryNames := map[int64]string{}
+	for _, category := range categories {
+		categoryNames[category.ID] = category.Name
+	}
+
+	return t.task.Stream(ctx, params, func(task entity.Task) error {
+		return fn(task, categoryNames[task.CategoryID.Int64])
+	})
+}
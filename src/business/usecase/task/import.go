@@ -0,0 +1,361 @@
+package task
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/ics"
+	"github.com/adiatma85/gg-project/utils/rank"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// Maximum number of tasks in one imported file
+const maxImportRows = 5000
+
+// Accepted time layouts of the CSV import
+var csvTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// Import read the tasks from the file and create them in one transaction together with the categories which
+// do not exist yet. Nothing is created when the file has an invalid row or it is a dry run
+func (t *task) Import(ctx context.Context, param entity.TaskImportParam, fileName string, r io.Reader) (entity.TaskImportResult, error) {
+	result := entity.TaskImportResult{DryRun: param.DryRun, NewCategories: []string{}, InvalidRows: []entity.TaskImportRow{}}
+
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	format := strings.ToLower(param.Format)
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), ".")
+	}
+
+	var items []entity.TaskFileItem
+	switch format {
+	case entity.TaskFileFormatICS:
+		items, err = readICSItems(r)
+	case entity.TaskFileFormatCSV:
+		items, err = readCSVItems(r, param.Mapping)
+	case entity.TaskFileFormatJSON:
+		err = json.NewDecoder(r).Decode(&items)
+	default:
+		return result, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid import format %q, must be one of ics, csv, json", format))
+	}
+	if err != nil {
+		return result, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("failed to read %s file: %v", format, err))
+	}
+
+	if len(items) > maxImportRows {
+		return result, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("file has %d tasks, the maximum is %d", len(items), maxImportRows))
+	}
+
+	// Imported tasks are personal, so they can only use the global categories
+	categories, err := t.getCategories(ctx, true)
+	if err != nil {
+		return result, err
+	}
+
+	// Category of the file is matched by its name regardless of the case
+	categoryIDs := map[string]int64{}
+	for _, category := range categories {
+		categoryIDs[strings.ToLower(category.Name)] = category.ID
+	}
+
+	rows := []entity.TaskImportRow{}
+	newCategories := map[string]bool{}
+	createCategories := []entity.CreateCategoryParam{}
+	for i, item := range items {
+		row := validateImportItem(i+1, item)
+		if len(row.Errors) > 0 {
+			result.InvalidRows = append(result.InvalidRows, row)
+			continue
+		}
+
+		if row.Category != "" {
+			key := strings.ToLower(row.Category)
+			if id, ok := categoryIDs[key]; ok {
+				row.Task.CategoryID = id
+			} else if !newCategories[key] {
+				newCategories[key] = true
+				result.NewCategories = append(result.NewCategories, row.Category)
+				createCategories = append(createCategories, entity.CreateCategoryParam{
+					Name:      row.Category,
+					CreatedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+					UpdatedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+				})
+			}
+		}
+
+		row.Task.UserId = user.User.ID
+		row.Task.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+		row.Task.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+		rows = append(rows, row)
+	}
+
+	result.Total = len(items)
+	result.Invalid = len(result.InvalidRows)
+	if result.Invalid > 0 || param.DryRun {
+		return result, nil
+	}
+
+	// Imported tasks are placed after the last personal task in the order of the file
+	position, err := t.getNextPosition(ctx, user.User.ID, null.Int64{})
+	if err != nil {
+		return result, err
+	}
+
+	for i := range rows {
+		rows[i].Task.Position = position
+		if position, err = rank.Between(position, ""); err != nil {
+			return result, errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+		}
+	}
+
+	tasks, err := t.task.Import(ctx, createCategories, rows)
+	if err != nil {
+		return result, err
+	}
+
+	result.Created = len(tasks)
+	for _, task := range tasks {
+		if err := t.recordStatusChange(ctx, task.ID, "", task.TaskStatus, user.User.ID); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// getCategories return all of the active categories, only the global ones when globalOnly is set
+func (t *task) getCategories(ctx context.Context, globalOnly bool) ([]entity.Category, error) {
+	categories, _, err := t.category.GetList(ctx, entity.CategoryParam{
+		RestrictWorkspace: globalOnly,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+
+	return categories, err
+}
+
+// validateImportItem convert the item into the create param and collect every problem of the item
+func validateImportItem(row int, item entity.TaskFileItem) entity.TaskImportRow {
+	result := entity.TaskImportRow{
+		Row:      row,
+		Title:    strings.TrimSpace(item.Title),
+		Errors:   []string{},
+		Category: strings.TrimSpace(item.Category),
+	}
+
+	if result.Title == "" {
+		result.Errors = append(result.Errors, "title is required")
+	}
+
+	if item.Priority < 0 {
+		result.Errors = append(result.Errors, "priority must not be negative")
+	}
+
+	status := strings.ToLower(item.TaskStatus)
+	if status == "" {
+		status = entity.TaskStatusTodo
+	} else if !isValidStatus(status) {
+		result.Errors = append(result.Errors, fmt.Sprintf("invalid task status %q, must be one of todo, ongoing, done", item.TaskStatus))
+	}
+
+	periodic := strings.ToLower(item.Periodic)
+	if periodic != "" && periodic != entity.TaskPeriodicNone && !isPeriodic(periodic) {
+		result.Errors = append(result.Errors, fmt.Sprintf("invalid periodic %q, must be one of none, daily, weekly, monthly, yearly", item.Periodic))
+	}
+
+	rrule := item.RRule
+	if rrule != "" {
+		var err error
+		if rrule, err = validateRRule(rrule); err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		}
+	}
+
+	if (isPeriodic(periodic) || rrule != "") && !item.DueTime.Valid {
+		result.Errors = append(result.Errors, "recurring task must have a due time")
+	}
+
+	result.Task = entity.CreateTaskParam{
+		Title:       result.Title,
+		Description: item.Description,
+		Priority:    item.Priority,
+		TaskStatus:  status,
+		Periodic:    periodic,
+		RRule:       rrule,
+		DueTime:     item.DueTime,
+	}
+
+	return result
+}
+
+// readICSItems read the VTODO of the calendar, VEVENT is not a task and is skipped
+func readICSItems(r io.Reader) ([]entity.TaskFileItem, error) {
+	components, err := ics.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []entity.TaskFileItem{}
+	for _, c := range components {
+		if c.Kind != ics.ComponentTodo {
+			continue
+		}
+
+		item := entity.TaskFileItem{
+			Title:       c.Summary,
+			Description: c.Description,
+			RRule:       c.RRule,
+		}
+
+		switch c.Status {
+		case ics.StatusCompleted:
+			item.TaskStatus = entity.TaskStatusDone
+		case ics.StatusInProcess:
+			item.TaskStatus = entity.TaskStatusOnGoing
+		default:
+			item.TaskStatus = entity.TaskStatusTodo
+		}
+
+		if len(c.Categories) > 0 {
+			item.Category = c.Categories[0]
+		}
+
+		if !c.Due.IsZero() {
+			item.DueTime = null.TimeFrom(c.Due)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// readCSVItems read the CSV with a header row, mapping is a JSON object of task field to CSV header and
+// the field name itself is used as the header when it is not mapped
+func readCSVItems(r io.Reader, mapping string) ([]entity.TaskFileItem, error) {
+	headers := map[string]string{}
+	for _, field := range entity.TaskFileCSVHeader {
+		headers[field] = field
+	}
+
+	if mapping != "" {
+		custom := map[string]string{}
+		if err := json.Unmarshal([]byte(mapping), &custom); err != nil {
+			return nil, fmt.Errorf("invalid mapping: %v", err)
+		}
+
+		for field, header := range custom {
+			if _, ok := headers[field]; !ok {
+				return nil, fmt.Errorf("invalid mapping field %q, must be one of %s", field, strings.Join(entity.TaskFileCSVHeader, ", "))
+			}
+			headers[field] = header
+		}
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return []entity.TaskFileItem{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Header written by spreadsheet apps may start with the UTF-8 byte order mark
+	columns := map[string]int{}
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+
+	index := map[string]int{}
+	for field, h := range headers {
+		if i, ok := columns[strings.ToLower(h)]; ok {
+			index[field] = i
+		}
+	}
+
+	if _, ok := index["title"]; !ok {
+		return nil, fmt.Errorf("header %q of the title is not found", headers["title"])
+	}
+
+	items := []entity.TaskFileItem{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		value := func(field string) string {
+			i, ok := index[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		line, _ := reader.FieldPos(0)
+		item := entity.TaskFileItem{
+			Title:       value("title"),
+			Description: value("description"),
+			Category:    value("category"),
+			TaskStatus:  value("taskStatus"),
+			Periodic:    value("periodic"),
+			RRule:       value("rrule"),
+		}
+
+		if v := value("priority"); v != "" {
+			if item.Priority, err = strconv.ParseInt(v, 10, 64); err != nil {
+				return nil, fmt.Errorf("line %d: invalid priority %q", line, v)
+			}
+		}
+
+		if v := value("dueTime"); v != "" {
+			dueTime, err := parseCSVTime(v)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid due time %q", line, v)
+			}
+			item.DueTime = null.TimeFrom(dueTime)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func parseCSVTime(value string) (time.Time, error) {
+	var err error
+	for _, layout := range csvTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
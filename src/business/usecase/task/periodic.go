@@ -0,0 +1,214 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+)
+
+// isPeriodic return true if the periodic value will spawn a next occurrence
+func isPeriodic(periodic string) bool {
+	switch periodic {
+	case entity.TaskPeriodicDaily, entity.TaskPeriodicWeekly, entity.TaskPeriodicMonthly, entity.TaskPeriodicYearly:
+		return true
+	}
+
+	return false
+}
+
+// addPeriod shift the time by n period. Monthly and yearly period keep the day of month
+// and clamp it to the last day when the target month is shorter (e.g. 31 Jan -> 28 Feb)
+func addPeriod(periodic string, t time.Time, n int) time.Time {
+	switch periodic {
+	case entity.TaskPeriodicDaily:
+		return t.AddDate(0, 0, n)
+	case entity.TaskPeriodicWeekly:
+		return t.AddDate(0, 0, 7*n)
+	case entity.TaskPeriodicMonthly:
+		return addMonthsClamped(t, n)
+	case entity.TaskPeriodicYearly:
+		return addMonthsClamped(t, 12*n)
+	}
+
+	return t
+}
+
+func addMonthsClamped(t time.Time, n int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+// nextDueTime return the first due time of the series that comes after now
+func nextDueTime(periodic string, prevDueTime, now time.Time) time.Time {
+	next := prevDueTime
+	for i := 1; !next.After(now); i++ {
+		next = addPeriod(periodic, prevDueTime, i)
+	}
+
+	return next
+}
+
+// periodicBetween return the occurrences of the periodic series inside [from, to], capped by limit
+func periodicBetween(periodic string, dtstart, from, to time.Time, limit int) []time.Time {
+	result := []time.Time{}
+	for i := 0; len(result) < limit; i++ {
+		occurrence := addPeriod(periodic, dtstart, i)
+		if occurrence.After(to) {
+			break
+		}
+
+		if !occurrence.Before(from) {
+			result = append(result, occurrence)
+		}
+	}
+
+	return result
+}
+
+// isRecurring return true if the task will spawn its next occurrence once it is done
+func isRecurring(task entity.Task) bool {
+	return task.RRule != "" || isPeriodic(task.Periodic)
+}
+
+// seriesStart return the DTSTART of the recurrence, which is the due time of the first task in the series
+func (t *task) seriesStart(ctx context.Context, current entity.Task) time.Time {
+	if current.SeriesID.Valid {
+		first, err := t.task.Get(ctx, entity.TaskParam{ID: current.SeriesID})
+		if err == nil && first.DueTime.Valid {
+			return first.DueTime.Time
+		}
+	}
+
+	if current.DueTime.Valid {
+		return current.DueTime.Time
+	}
+
+	return current.CreatedAt.Time
+}
+
+// nextSeriesDueTime return the due time of the next occurrence, false means the series is already ended
+func (t *task) nextSeriesDueTime(ctx context.Context, current entity.Task) (time.Time, bool, error) {
+	if current.RRule == "" {
+		// Task without due time will be scheduled from the time it is completed
+		prevDueTime := Now()
+		if current.DueTime.Valid {
+			prevDueTime = current.DueTime.Time
+		}
+
+		return nextDueTime(current.Periodic, prevDueTime, Now()), true, nil
+	}
+
+	rule, err := parseRRule(current.RRule)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	after := Now()
+	if current.DueTime.Valid && current.DueTime.Time.After(after) {
+		after = current.DueTime.Time
+	}
+
+	next, ok := rule.after(t.seriesStart(ctx, current), after)
+
+	return next, ok, nil
+}
+
+// spawnNextOccurrence create the next task of a recurring series after the current one is done
+func (t *task) spawnNextOccurrence(ctx context.Context, current entity.Task, userID int64) error {
+	seriesID := current.SeriesID
+	if !seriesID.Valid {
+		seriesID = null.Int64From(current.ID)
+	}
+
+	dueTime, ok, err := t.nextSeriesDueTime(ctx, current)
+	if err != nil {
+		return err
+	} else if !ok {
+		t.log.Info(ctx, fmt.Sprintf("recurrence of series %d has ended", seriesID.Int64))
+		return nil
+	}
+
+	// Skip if the occurrence already spawned, e.g. the task was reopened and completed again
+	_, err = t.task.Get(ctx, entity.TaskParam{
+		SeriesID: seriesID,
+		DueTime:  null.TimeFrom(dueTime),
+	})
+	if err == nil {
+		return nil
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return err
+	}
+
+	// Next occurrence takes the place of the current one in the order of the tasks
+	position, err := t.getPositionAfter(ctx, current, userID)
+	if err != nil {
+		return err
+	}
+
+	nextTask, err := t.task.Create(ctx, entity.CreateTaskParam{
+		UserId:      current.UserId,
+		AssigneeID:  current.AssigneeID,
+		WorkspaceID: current.WorkspaceID,
+		CategoryID:  current.CategoryID.Int64,
+		SeriesID:    seriesID,
+		Title:       current.Title,
+		Description: current.Description,
+		Priority:    current.Priority,
+		TaskStatus:  entity.TaskStatusTodo,
+		Periodic:    current.Periodic,
+		RRule:       current.RRule,
+		DueTime:     null.TimeFrom(dueTime),
+		Position:    position,
+		CreatedBy:   null.StringFrom(fmt.Sprintf("%v", userID)),
+		UpdatedBy:   null.StringFrom(fmt.Sprintf("%v", userID)),
+	})
+	if errors.GetCode(err) == codes.CodeConflict {
+		// The unique series index caught a concurrent completion which spawned the occurrence first
+		t.log.Info(ctx, fmt.Sprintf("next occurrence of series %d already spawned", seriesID.Int64))
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := t.recordStatusChange(ctx, nextTask.ID, "", nextTask.TaskStatus, userID); err != nil {
+		return err
+	}
+
+	if err := t.copyReminders(ctx, current, nextTask, userID); err != nil {
+		return err
+	}
+
+	t.log.Info(ctx, fmt.Sprintf("spawned task %d as next occurrence of series %d", nextTask.ID, seriesID.Int64))
+
+	return nil
+}
+
+// occurrencesBetween return the due times of the task inside [from, to], recurring task is expanded into its series
+func (t *task) occurrencesBetween(ctx context.Context, task entity.Task, from, to time.Time) ([]time.Time, error) {
+	switch {
+	case task.RRule != "":
+		rule, err := parseRRule(task.RRule)
+		if err != nil {
+			return nil, err
+		}
+		return rule.between(t.seriesStart(ctx, task), from, to, maxOccurrences), nil
+	case isPeriodic(task.Periodic):
+		return periodicBetween(task.Periodic, t.seriesStart(ctx, task), from, to, maxOccurrences), nil
+	case task.DueTime.Valid && !task.DueTime.Time.Before(from) && !task.DueTime.Time.After(to):
+		return []time.Time{task.DueTime.Time}, nil
+	}
+
+	return nil, nil
+}
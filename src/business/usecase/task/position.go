@@ -0,0 +1,283 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/rank"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// Move place the task between its anchors, only the position of the moved task is changed unless the keys between the
+// anchors are used up and the visible tasks are rebalanced
+func (t *task) Move(ctx context.Context, selectParam entity.TaskParam, req entity.MoveTaskParam) (entity.Task, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	task, err := t.Get(ctx, selectParam)
+	if err != nil {
+		return task, err
+	}
+
+	if !req.Before.Valid && !req.After.Valid {
+		return task, errors.NewWithCode(codes.CodeBadRequest, "before or after is required")
+	}
+
+	if (req.Before.Valid && req.Before.Int64 == task.ID) || (req.After.Valid && req.After.Int64 == task.ID) {
+		return task, errors.NewWithCode(codes.CodeBadRequest, "task can not be moved next to itself")
+	}
+
+	var lower, upper string
+	if req.After.Valid {
+		after, err := t.getAnchor(ctx, req.After.Int64)
+		if err != nil {
+			return task, err
+		}
+		lower = after.Position
+	}
+
+	if req.Before.Valid {
+		before, err := t.getAnchor(ctx, req.Before.Int64)
+		if err != nil {
+			return task, err
+		}
+		upper = before.Position
+	}
+
+	if req.After.Valid && req.Before.Valid && lower >= upper {
+		return task, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("task %d is not before task %d", req.After.Int64, req.Before.Int64))
+	}
+
+	// The missing anchor is the neighbor of the given one among the tasks the user can see
+	visibleParam := entity.TaskParam{}
+	if err := t.applyAccessFilter(ctx, &visibleParam, user.User.ID); err != nil {
+		return task, err
+	}
+
+	if !req.Before.Valid {
+		upper, err = t.getNeighborPosition(ctx, visibleParam, null.StringFrom(lower), null.String{})
+	} else if !req.After.Valid {
+		lower, err = t.getNeighborPosition(ctx, visibleParam, null.String{}, null.StringFrom(upper))
+	}
+	if err != nil {
+		return task, err
+	}
+
+	position, err := rank.Between(lower, upper)
+	if err != nil || len(position) > rank.RebalanceLength {
+		// The gap between the anchors is used up, so the visible tasks get new keys with the task placed after lower
+		tasks, err := t.rebalance(ctx, visibleParam, task, lower, user.User.ID)
+		if err != nil {
+			return task, err
+		}
+
+		for _, moved := range tasks {
+			if moved.ID == task.ID {
+				task.Position, task.UpdatedAt, task.UpdatedBy = moved.Position, moved.UpdatedAt, moved.UpdatedBy
+			}
+		}
+
+		return task, nil
+	}
+
+	updateParam := entity.UpdateTaskParam{
+		Position:  null.StringFrom(position),
+		UpdatedAt: null.TimeFrom(Now()),
+		UpdatedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	if err := t.task.Update(ctx, updateParam, entity.TaskParam{ID: null.Int64From(task.ID)}); err != nil {
+		return task, err
+	}
+
+	task.Position = position
+	task.UpdatedAt = updateParam.UpdatedAt
+	task.UpdatedBy = updateParam.UpdatedBy
+
+	return task, nil
+}
+
+// getAnchor return the task the moved task is placed next to, it must be visible to the user
+func (t *task) getAnchor(ctx context.Context, taskID int64) (entity.Task, error) {
+	anchor, err := t.Get(ctx, entity.TaskParam{ID: null.Int64From(taskID)})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return anchor, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("task %d does not exist", taskID))
+	}
+
+	return anchor, err
+}
+
+// getNeighborPosition return the closest position above gt or below lt among the tasks of the params, empty when
+// there is no task on that side
+func (t *task) getNeighborPosition(ctx context.Context, params entity.TaskParam, gt, lt null.String) (string, error) {
+	params.PositionGT, params.PositionLT = gt, lt
+
+	params.SortBy = []string{"position"}
+	if lt.Valid {
+		params.SortBy = []string{"-position"}
+	}
+
+	return t.getFirstPosition(ctx, params)
+}
+
+// getNextPosition return the position after the last task of the owner, or of the workspace on the workspace task
+func (t *task) getNextPosition(ctx context.Context, userID int64, workspaceID null.Int64) (string, error) {
+	params := positionScope(userID, workspaceID)
+	params.SortBy = []string{"-position"}
+
+	last, err := t.getFirstPosition(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	position, err := rank.Between(last, "")
+	if err != nil {
+		return "", errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	return position, nil
+}
+
+// getPositionAfter return the position right after the task among the tasks of its owner or its workspace, the tasks
+// are rebalanced when the gap after the task is used up
+func (t *task) getPositionAfter(ctx context.Context, task entity.Task, userID int64) (string, error) {
+	if task.Position == "" {
+		return t.getNextPosition(ctx, task.UserId, task.WorkspaceID)
+	}
+
+	scope := positionScope(task.UserId, task.WorkspaceID)
+
+	next, err := t.getNeighborPosition(ctx, scope, null.StringFrom(task.Position), null.String{})
+	if err != nil {
+		return "", err
+	}
+
+	position, err := rank.Between(task.Position, next)
+	if err == nil && len(position) <= rank.RebalanceLength {
+		return position, nil
+	}
+
+	tasks, err := t.rebalance(ctx, scope, entity.Task{}, "", userID)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range tasks {
+		if tasks[i].ID != task.ID {
+			continue
+		}
+
+		next = ""
+		if i+1 < len(tasks) {
+			next = tasks[i+1].Position
+		}
+
+		position, err = rank.Between(tasks[i].Position, next)
+		if err != nil {
+			return "", errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+		}
+
+		return position, nil
+	}
+
+	return t.getNextPosition(ctx, task.UserId, task.WorkspaceID)
+}
+
+// rebalance give the tasks of the params short keys in their current order, the moved task is placed after the last
+// task at or before lower. The keys are written in one transaction and the tasks are returned in their new order
+func (t *task) rebalance(ctx context.Context, params entity.TaskParam, moved entity.Task, lower string, userID int64) ([]entity.Task, error) {
+	params.SortBy = []string{"position", "id"}
+	params.QueryOption = query.Option{
+		IsActive: true,
+	}
+
+	list, _, err := t.task.GetList(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []entity.Task{}
+	for _, task := range list {
+		if task.ID != moved.ID {
+			tasks = append(tasks, task)
+		}
+	}
+
+	if moved.ID > 0 {
+		i := 0
+		for i < len(tasks) && lower != "" && tasks[i].Position <= lower {
+			i++
+		}
+		tasks = append(tasks[:i], append([]entity.Task{moved}, tasks[i:]...)...)
+	}
+
+	keys, err := rank.Sequence(len(tasks))
+	if err != nil {
+		return nil, errors.NewWithCode(codes.CodeInternalServerError, err.Error())
+	}
+
+	updatedAt := null.TimeFrom(Now())
+	updatedBy := null.StringFrom(fmt.Sprintf("%v", userID))
+
+	updates := []entity.TaskBulkUpdate{}
+	for i := range tasks {
+		if tasks[i].Position == keys[i] {
+			continue
+		}
+
+		tasks[i].Position = keys[i]
+		tasks[i].UpdatedAt = updatedAt
+		tasks[i].UpdatedBy = updatedBy
+		updates = append(updates, entity.TaskBulkUpdate{
+			UpdateParam: entity.UpdateTaskParam{
+				Position:  null.StringFrom(keys[i]),
+				UpdatedAt: updatedAt,
+				UpdatedBy: updatedBy,
+			},
+			SelectParam: entity.TaskParam{ID: null.Int64From(tasks[i].ID)},
+		})
+	}
+
+	t.log.Info(ctx, fmt.Sprintf("rebalance the position of %d tasks", len(updates)))
+
+	if err := t.task.UpdateBulk(ctx, updates); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// getFirstPosition return the position of the first active task in the sort order of the params
+func (t *task) getFirstPosition(ctx context.Context, params entity.TaskParam) (string, error) {
+	params.Limit = 1
+	params.QueryOption = query.Option{
+		IsActive: true,
+	}
+
+	tasks, _, err := t.task.GetList(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	if len(tasks) < 1 {
+		return "", nil
+	}
+
+	return tasks[0].Position, nil
+}
+
+// positionScope return the tasks which share the order with the new task, the personal tasks of the owner or the
+// tasks of the workspace
+func positionScope(userID int64, workspaceID null.Int64) entity.TaskParam {
+	if workspaceID.Valid {
+		return entity.TaskParam{WorkspaceID: workspaceID}
+	}
+
+	return entity.TaskParam{UserId: null.Int64From(userID), PersonalOnly: true}
+}
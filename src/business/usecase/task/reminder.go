@@ -0,0 +1,80 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// rescheduleReminders recompute the unsent reminders of the task after its due time is changed
+func (t *task) rescheduleReminders(ctx context.Context, taskID int64, dueTime time.Time, userID int64) error {
+	reminders, _, err := t.taskReminder.GetList(ctx, entity.TaskReminderParam{
+		TaskID: null.Int64From(taskID),
+		Unsent: true,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range reminders {
+		updateParam := entity.UpdateTaskReminderParam{
+			RemindAt:   null.TimeFrom(r.ScheduleAt(dueTime)),
+			ClaimToken: null.StringFrom(""),
+			Attempts:   null.Int64From(0),
+			LastError:  null.StringFrom(""),
+			UpdatedAt:  null.TimeFrom(Now()),
+			UpdatedBy:  null.StringFrom(fmt.Sprintf("%v", userID)),
+		}
+
+		if err := t.taskReminder.Update(ctx, updateParam, entity.TaskReminderParam{ID: null.Int64From(r.ID)}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyReminders give the next occurrence of a recurring task the same reminders as the previous one
+func (t *task) copyReminders(ctx context.Context, from entity.Task, to entity.Task, userID int64) error {
+	if !to.DueTime.Valid {
+		return nil
+	}
+
+	reminders, _, err := t.taskReminder.GetList(ctx, entity.TaskReminderParam{
+		TaskID: null.Int64From(from.ID),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range reminders {
+		_, err := t.taskReminder.Create(ctx, entity.CreateTaskReminderParam{
+			TaskID:        to.ID,
+			UserID:        r.UserID,
+			Kind:          r.Kind,
+			OffsetMinutes: r.OffsetMinutes,
+			TimeOfDay:     r.TimeOfDay,
+			Timezone:      r.Timezone,
+			RemindAt:      null.TimeFrom(r.ScheduleAt(to.DueTime.Time)),
+			CreatedBy:     null.StringFrom(fmt.Sprintf("%v", userID)),
+			UpdatedBy:     null.StringFrom(fmt.Sprintf("%v", userID)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,466 @@
+package task
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+)
+
+// Supported subset of RFC 5545 recurrence rule, only date based frequencies are allowed
+const (
+	rruleFreqDaily   = "DAILY"
+	rruleFreqWeekly  = "WEEKLY"
+	rruleFreqMonthly = "MONTHLY"
+	rruleFreqYearly  = "YEARLY"
+
+	// Guard for rules that rarely or never produce an occurrence (e.g. BYMONTH=2;BYMONTHDAY=30)
+	maxRRulePeriods = 10000
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+type rruleWeekday struct {
+	weekday time.Weekday
+	// n is the ordinal of the weekday inside the month or year, 0 means every weekday
+	n int
+}
+
+type rrule struct {
+	freq       string
+	interval   int
+	count      int
+	until      time.Time
+	byDay      []rruleWeekday
+	byMonthDay []int
+	byMonth    []int
+	bySetPos   []int
+	wkst       time.Weekday
+}
+
+// normalizeRRule strip the optional "RRULE:" prefix and uppercase the rule
+func normalizeRRule(value string) string {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	return strings.TrimPrefix(value, "RRULE:")
+}
+
+func parseRRule(value string) (rrule, error) {
+	r := rrule{
+		interval: 1,
+		wkst:     time.Monday,
+	}
+
+	value = normalizeRRule(value)
+	if value == "" {
+		return r, errors.NewWithCode(codes.CodeBadRequest, "rrule is empty")
+	}
+
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+
+		key, val, found := strings.Cut(part, "=")
+		if !found || val == "" {
+			return r, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid rrule part %q", part))
+		}
+
+		var err error
+		switch key {
+		case "FREQ":
+			switch val {
+			case rruleFreqDaily, rruleFreqWeekly, rruleFreqMonthly, rruleFreqYearly:
+				r.freq = val
+			default:
+				err = fmt.Errorf("unsupported FREQ %q", val)
+			}
+		case "INTERVAL":
+			r.interval, err = parseRRuleInt(val, 1, 1000)
+		case "COUNT":
+			r.count, err = parseRRuleInt(val, 1, 1000)
+		case "UNTIL":
+			r.until, err = parseRRuleUntil(val)
+		case "BYDAY":
+			r.byDay, err = parseRRuleByDay(val)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseRRuleIntList(val, 1, 31, true)
+		case "BYMONTH":
+			r.byMonth, err = parseRRuleIntList(val, 1, 12, false)
+		case "BYSETPOS":
+			r.bySetPos, err = parseRRuleIntList(val, 1, 366, true)
+		case "WKST":
+			weekday, ok := rruleWeekdays[val]
+			if !ok {
+				err = fmt.Errorf("invalid WKST %q", val)
+			}
+			r.wkst = weekday
+		default:
+			err = fmt.Errorf("unsupported rule part %q", key)
+		}
+
+		if err != nil {
+			return r, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid rrule: %s", err.Error()))
+		}
+	}
+
+	if r.freq == "" {
+		return r, errors.NewWithCode(codes.CodeBadRequest, "invalid rrule: FREQ is required")
+	}
+
+	if r.count > 0 && !r.until.IsZero() {
+		return r, errors.NewWithCode(codes.CodeBadRequest, "invalid rrule: COUNT and UNTIL cannot be used together")
+	}
+
+	if r.freq == rruleFreqDaily || r.freq == rruleFreqWeekly {
+		for _, d := range r.byDay {
+			if d.n != 0 {
+				return r, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid rrule: BYDAY ordinal is not allowed with FREQ=%s", r.freq))
+			}
+		}
+	}
+
+	if r.freq == rruleFreqWeekly && len(r.byMonthDay) > 0 {
+		return r, errors.NewWithCode(codes.CodeBadRequest, "invalid rrule: BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	}
+
+	return r, nil
+}
+
+func parseRRuleInt(value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+
+	if n < min || n > max {
+		return 0, fmt.Errorf("%d is out of range [%d, %d]", n, min, max)
+	}
+
+	return n, nil
+}
+
+func parseRRuleIntList(value string, min, max int, allowNegative bool) ([]int, error) {
+	result := []int{}
+	for _, v := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", v)
+		}
+
+		abs := n
+		if n < 0 && allowNegative {
+			abs = -n
+		}
+
+		if abs < min || abs > max {
+			return nil, fmt.Errorf("%d is out of range", n)
+		}
+
+		result = append(result, n)
+	}
+
+	return result, nil
+}
+
+func parseRRuleUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				// Date only UNTIL is inclusive for the whole day
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", value)
+}
+
+func parseRRuleByDay(value string) ([]rruleWeekday, error) {
+	result := []rruleWeekday{}
+	for _, v := range strings.Split(value, ",") {
+		if len(v) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY %q", v)
+		}
+
+		weekday, ok := rruleWeekdays[v[len(v)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY %q", v)
+		}
+
+		n := 0
+		if ordinal := v[:len(v)-2]; ordinal != "" {
+			var err error
+			n, err = strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid BYDAY ordinal %q", v)
+			}
+		}
+
+		result = append(result, rruleWeekday{weekday: weekday, n: n})
+	}
+
+	return result, nil
+}
+
+// between return the occurrences inside [from, to], capped by limit
+func (r rrule) between(dtstart, from, to time.Time, limit int) []time.Time {
+	result := []time.Time{}
+	r.iterate(dtstart, func(t time.Time) bool {
+		if t.After(to) {
+			return false
+		}
+		if !t.Before(from) {
+			result = append(result, t)
+		}
+		return len(result) < limit
+	})
+
+	return result
+}
+
+// after return the first occurrence strictly after t
+func (r rrule) after(dtstart, t time.Time) (time.Time, bool) {
+	var (
+		result time.Time
+		found  bool
+	)
+
+	r.iterate(dtstart, func(occurrence time.Time) bool {
+		if occurrence.After(t) {
+			result, found = occurrence, true
+			return false
+		}
+		return true
+	})
+
+	return result, found
+}
+
+// iterate walk every occurrence in chronological order until fn return false or the rule ends
+func (r rrule) iterate(dtstart time.Time, fn func(time.Time) bool) {
+	count := 0
+	for i := 0; i < maxRRulePeriods; i++ {
+		periodStart, candidates := r.periodCandidates(dtstart, i)
+		if !r.until.IsZero() && periodStart.After(r.until) {
+			return
+		}
+
+		for _, c := range candidates {
+			if c.Before(dtstart) {
+				continue
+			}
+
+			if !r.until.IsZero() && c.After(r.until) {
+				return
+			}
+
+			count++
+			if !fn(c) {
+				return
+			}
+
+			if r.count > 0 && count >= r.count {
+				return
+			}
+		}
+	}
+}
+
+// periodCandidates return the start of the i-th period and the sorted occurrences inside it
+func (r rrule) periodCandidates(dtstart time.Time, i int) (time.Time, []time.Time) {
+	var (
+		periodStart time.Time
+		candidates  []time.Time
+	)
+
+	hour, minute, second := dtstart.Clock()
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, minute, second, 0, dtstart.Location())
+	}
+
+	switch r.freq {
+	case rruleFreqDaily:
+		periodStart = date(dtstart.Year(), dtstart.Month(), dtstart.Day()+i*r.interval)
+		last := daysIn(periodStart.Year(), periodStart.Month())
+		if r.matchMonth(periodStart) && r.matchMonthDay(periodStart.Day(), last) && r.matchByDay(periodStart, periodStart.Day(), last) {
+			candidates = append(candidates, periodStart)
+		}
+
+	case rruleFreqWeekly:
+		offset := (int(dtstart.Weekday()) - int(r.wkst) + 7) % 7
+		periodStart = date(dtstart.Year(), dtstart.Month(), dtstart.Day()-offset+7*i*r.interval)
+		for k := 0; k < 7; k++ {
+			d := periodStart.AddDate(0, 0, k)
+			if !r.matchMonth(d) {
+				continue
+			}
+
+			if len(r.byDay) == 0 && d.Weekday() != dtstart.Weekday() {
+				continue
+			}
+
+			if !r.matchByDay(d, 0, 0) {
+				continue
+			}
+
+			candidates = append(candidates, d)
+		}
+
+	case rruleFreqMonthly:
+		periodStart = date(dtstart.Year(), dtstart.Month()+time.Month(i*r.interval), 1)
+		if r.matchMonth(periodStart) {
+			candidates = r.monthCandidates(periodStart, dtstart)
+		}
+
+	case rruleFreqYearly:
+		year := dtstart.Year() + i*r.interval
+		periodStart = date(year, time.January, 1)
+
+		switch {
+		case len(r.byMonth) > 0:
+			months := append([]int{}, r.byMonth...)
+			sort.Ints(months)
+			for _, m := range months {
+				candidates = append(candidates, r.monthCandidates(date(year, time.Month(m), 1), dtstart)...)
+			}
+		case len(r.byDay) > 0:
+			// Without BYMONTH, the BYDAY ordinal is relative to the whole year
+			last := date(year, time.December, 31).YearDay()
+			for d := periodStart; d.Year() == year; d = d.AddDate(0, 0, 1) {
+				if r.matchMonthDay(d.Day(), daysIn(year, d.Month())) && r.matchByDay(d, d.YearDay(), last) {
+					candidates = append(candidates, d)
+				}
+			}
+		case len(r.byMonthDay) > 0:
+			for m := time.January; m <= time.December; m++ {
+				candidates = append(candidates, r.monthCandidates(date(year, m, 1), dtstart)...)
+			}
+		default:
+			if dtstart.Day() <= daysIn(year, dtstart.Month()) {
+				candidates = append(candidates, date(year, dtstart.Month(), dtstart.Day()))
+			}
+		}
+	}
+
+	return periodStart, r.applySetPos(candidates)
+}
+
+// monthCandidates return the days of the month starting at monthStart that match the rule
+func (r rrule) monthCandidates(monthStart, dtstart time.Time) []time.Time {
+	candidates := []time.Time{}
+	last := daysIn(monthStart.Year(), monthStart.Month())
+
+	if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		// Month without the day of dtstart (e.g. 31st) is skipped as in RFC 5545
+		if dtstart.Day() <= last {
+			candidates = append(candidates, monthStart.AddDate(0, 0, dtstart.Day()-1))
+		}
+		return candidates
+	}
+
+	for day := 1; day <= last; day++ {
+		d := monthStart.AddDate(0, 0, day-1)
+		if r.matchMonthDay(day, last) && r.matchByDay(d, day, last) {
+			candidates = append(candidates, d)
+		}
+	}
+
+	return candidates
+}
+
+func (r rrule) matchMonth(t time.Time) bool {
+	if len(r.byMonth) == 0 {
+		return true
+	}
+
+	for _, m := range r.byMonth {
+		if time.Month(m) == t.Month() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r rrule) matchMonthDay(day, lastDay int) bool {
+	if len(r.byMonthDay) == 0 {
+		return true
+	}
+
+	for _, md := range r.byMonthDay {
+		if (md > 0 && md == day) || (md < 0 && lastDay+md+1 == day) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchByDay check the weekday and its ordinal, idx is the 1-based position of t inside a range of size total
+func (r rrule) matchByDay(t time.Time, idx, total int) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+
+	for _, d := range r.byDay {
+		if d.weekday != t.Weekday() {
+			continue
+		}
+
+		switch {
+		case d.n == 0:
+			return true
+		case d.n > 0 && (idx-1)/7+1 == d.n:
+			return true
+		case d.n < 0 && -((total-idx)/7+1) == d.n:
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r rrule) applySetPos(candidates []time.Time) []time.Time {
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Before(candidates[j])
+	})
+
+	if len(r.bySetPos) == 0 || len(candidates) == 0 {
+		return candidates
+	}
+
+	result := []time.Time{}
+	for _, pos := range r.bySetPos {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(candidates) + pos
+		}
+
+		if idx >= 0 && idx < len(candidates) {
+			result = append(result, candidates[idx])
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Before(result[j])
+	})
+
+	return result
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
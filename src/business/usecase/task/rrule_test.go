@@ -0,0 +1,281 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		wantFreq     string
+		wantInterval int
+		wantCount    int
+		wantUntil    time.Time
+		wantErr      bool
+	}{
+		{name: "daily", value: "FREQ=DAILY", wantFreq: rruleFreqDaily, wantInterval: 1},
+		{name: "prefix and lower case", value: "rrule:freq=weekly;byday=mo,we", wantFreq: rruleFreqWeekly, wantInterval: 1},
+		{name: "interval and count", value: "FREQ=MONTHLY;INTERVAL=3;COUNT=4", wantFreq: rruleFreqMonthly, wantInterval: 3, wantCount: 4},
+		{name: "until in utc", value: "FREQ=DAILY;UNTIL=20261018T100000Z", wantFreq: rruleFreqDaily, wantInterval: 1, wantUntil: time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)},
+		{name: "floating until is read as utc", value: "FREQ=DAILY;UNTIL=20261018T100000", wantFreq: rruleFreqDaily, wantInterval: 1, wantUntil: time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)},
+		{name: "date until includes the whole day", value: "FREQ=DAILY;UNTIL=20261018", wantFreq: rruleFreqDaily, wantInterval: 1, wantUntil: time.Date(2026, 10, 18, 23, 59, 59, 0, time.UTC)},
+		{name: "last friday of the month", value: "FREQ=MONTHLY;BYDAY=-1FR", wantFreq: rruleFreqMonthly, wantInterval: 1},
+		{name: "empty", value: "", wantErr: true},
+		{name: "missing freq", value: "INTERVAL=2", wantErr: true},
+		{name: "unsupported freq", value: "FREQ=HOURLY", wantErr: true},
+		{name: "part without value", value: "FREQ", wantErr: true},
+		{name: "unsupported part", value: "FREQ=DAILY;BYHOUR=9", wantErr: true},
+		{name: "count and until", value: "FREQ=DAILY;COUNT=2;UNTIL=20261018", wantErr: true},
+		{name: "count out of range", value: "FREQ=DAILY;COUNT=0", wantErr: true},
+		{name: "invalid until", value: "FREQ=DAILY;UNTIL=2026", wantErr: true},
+		{name: "month day out of range", value: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "ordinal weekday on weekly", value: "FREQ=WEEKLY;BYDAY=1MO", wantErr: true},
+		{name: "month day on weekly", value: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{name: "invalid weekday", value: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "invalid week start", value: "FREQ=WEEKLY;WKST=XX", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRRule(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRRule(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.freq != tt.wantFreq || got.interval != tt.wantInterval || got.count != tt.wantCount {
+				t.Errorf("parseRRule(%q) = freq %s, interval %d, count %d, want freq %s, interval %d, count %d",
+					tt.value, got.freq, got.interval, got.count, tt.wantFreq, tt.wantInterval, tt.wantCount)
+			}
+
+			if !got.until.Equal(tt.wantUntil) {
+				t.Errorf("parseRRule(%q) until = %v, want %v", tt.value, got.until, tt.wantUntil)
+			}
+		})
+	}
+}
+
+func TestRRuleBetween(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		rule    string
+		dtstart time.Time
+		from    time.Time
+		to      time.Time
+		limit   int
+		want    []string
+	}{
+		{
+			name:    "daily with count",
+			rule:    "FREQ=DAILY;COUNT=3",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-01", "2026-01-02", "2026-01-03"},
+		},
+		{
+			name:    "count is counted from the start of the series",
+			rule:    "FREQ=DAILY;COUNT=5",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 4),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-04", "2026-01-05"},
+		},
+		{
+			name:    "interval with until",
+			rule:    "FREQ=DAILY;INTERVAL=2;UNTIL=20260110T000000Z",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-01", "2026-01-03", "2026-01-05", "2026-01-07", "2026-01-09"},
+		},
+		{
+			name:    "date until includes its day",
+			rule:    "FREQ=DAILY;UNTIL=20260103",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-01", "2026-01-02", "2026-01-03"},
+		},
+		{
+			name:    "weekly on several days",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=4",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-02", "2026-01-05", "2026-01-07", "2026-01-09"},
+		},
+		{
+			name:    "monthly on the 31st skips the shorter months",
+			rule:    "FREQ=MONTHLY;COUNT=3",
+			dtstart: date(2026, 1, 31),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-31", "2026-03-31", "2026-05-31"},
+		},
+		{
+			name:    "monthly on the last day",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=4",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"},
+		},
+		{
+			name:    "monthly on the last friday",
+			rule:    "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-30", "2026-02-27", "2026-03-27"},
+		},
+		{
+			name:    "monthly on the last weekday",
+			rule:    "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   100,
+			want:    []string{"2026-01-30", "2026-02-27", "2026-03-31"},
+		},
+		{
+			name:    "yearly on the leap day skips the common years",
+			rule:    "FREQ=YEARLY;COUNT=2",
+			dtstart: date(2024, 2, 29),
+			from:    date(2024, 1, 1),
+			to:      date(2030, 12, 31),
+			limit:   100,
+			want:    []string{"2024-02-29", "2028-02-29"},
+		},
+		{
+			name:    "limit caps the result",
+			rule:    "FREQ=DAILY",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			limit:   2,
+			want:    []string{"2026-01-01", "2026-01-02"},
+		},
+		{
+			name:    "rule which never occurs stops at the period cap",
+			rule:    "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(9999, 12, 31),
+			limit:   100,
+			want:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("parseRRule(%q) error = %v", tt.rule, err)
+			}
+
+			got := formatDates(r.between(tt.dtstart, tt.from, tt.to, tt.limit))
+			if !equalDates(got, tt.want) {
+				t.Errorf("between() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRuleAfter(t *testing.T) {
+	dtstart := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		rule      string
+		t         time.Time
+		want      time.Time
+		wantFound bool
+	}{
+		{name: "next month with the day", rule: "FREQ=MONTHLY", t: dtstart, want: time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC), wantFound: true},
+		{name: "same day later is strictly after", rule: "FREQ=DAILY", t: dtstart.Add(-time.Minute), want: dtstart, wantFound: true},
+		{name: "count is used up", rule: "FREQ=DAILY;COUNT=2", t: dtstart.AddDate(0, 0, 1), wantFound: false},
+		{name: "until is passed", rule: "FREQ=DAILY;UNTIL=20260201", t: dtstart.AddDate(0, 0, 1), wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("parseRRule(%q) error = %v", tt.rule, err)
+			}
+
+			got, found := r.after(dtstart, tt.t)
+			if found != tt.wantFound || !got.Equal(tt.want) {
+				t.Errorf("after() = %v, %v, want %v, %v", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestAddPeriod(t *testing.T) {
+	tests := []struct {
+		name     string
+		periodic string
+		t        time.Time
+		n        int
+		want     time.Time
+	}{
+		{name: "daily", periodic: entity.TaskPeriodicDaily, t: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), n: 1, want: time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)},
+		{name: "weekly", periodic: entity.TaskPeriodicWeekly, t: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), n: 2, want: time.Date(2026, 2, 14, 9, 0, 0, 0, time.UTC)},
+		{name: "monthly clamps to the end of the month", periodic: entity.TaskPeriodicMonthly, t: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), n: 1, want: time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC)},
+		{name: "monthly keeps the day of the series", periodic: entity.TaskPeriodicMonthly, t: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), n: 2, want: time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC)},
+		{name: "yearly clamps the leap day", periodic: entity.TaskPeriodicYearly, t: time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC), n: 1, want: time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC)},
+		{name: "none does not move", periodic: entity.TaskPeriodicNone, t: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), n: 1, want: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := addPeriod(tt.periodic, tt.t, tt.n); !got.Equal(tt.want) {
+				t.Errorf("addPeriod(%q, %v, %d) = %v, want %v", tt.periodic, tt.t, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func formatDates(times []time.Time) []string {
+	result := []string{}
+	for _, t := range times {
+		result = append(result, t.Format("2006-01-02"))
+	}
+
+	return result
+}
+
+func equalDates(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
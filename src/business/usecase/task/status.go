@@ -0,0 +1,177 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
+)
+
+// isValidStatus return true if the value is one of the known task statuses
+func isValidStatus(status string) bool {
+	switch status {
+	case entity.TaskStatusTodo, entity.TaskStatusOnGoing, entity.TaskStatusDone:
+		return true
+	}
+
+	return false
+}
+
+// validateTransition check the transition against the task state machine.
+// The regular flow is todo -> ongoing -> done and ongoing task can always go back to todo,
+// skipping ongoing and reopening a done task depend on the config
+func (t *task) validateTransition(from, to string) error {
+	if !isValidStatus(to) {
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid task status %q, must be one of todo, ongoing, done", to))
+	}
+
+	// Task created before the status was validated is treated as todo
+	if !isValidStatus(from) {
+		from = entity.TaskStatusTodo
+	}
+
+	isAllowed := false
+	switch {
+	case from == to:
+		isAllowed = true
+	case from == entity.TaskStatusTodo && to == entity.TaskStatusOnGoing:
+		isAllowed = true
+	case from == entity.TaskStatusOnGoing && to == entity.TaskStatusDone:
+		isAllowed = true
+	case from == entity.TaskStatusOnGoing && to == entity.TaskStatusTodo:
+		isAllowed = true
+	case from == entity.TaskStatusTodo && to == entity.TaskStatusDone:
+		isAllowed = t.conf.AllowSkipOngoing
+	case from == entity.TaskStatusDone:
+		isAllowed = t.conf.AllowReopen
+	}
+
+	if !isAllowed {
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("task status can not be changed from %s to %s", from, to))
+	}
+
+	return nil
+}
+
+// validateStatusChange check the transition of the task, blocked task can not be started or finished until all of its
+// blockers are done
+func (t *task) validateStatusChange(ctx context.Context, task entity.Task, to string) error {
+	if err := t.validateTransition(task.TaskStatus, to); err != nil {
+		return err
+	}
+
+	isProgressing := to == entity.TaskStatusOnGoing || to == entity.TaskStatusDone
+	if isProgressing && to != task.TaskStatus {
+		return t.checkBlockers(ctx, task.ID)
+	}
+
+	return nil
+}
+
+// ChangeStatus move the task to the status, fn runs in the transaction of the status change so the caller can write
+// its own rows together with the new status. The side effects of the change run once it is committed
+func (t *task) ChangeStatus(ctx context.Context, selectParam entity.TaskParam, status string, fn func(tx sql.CommandTx) (sql.CommandTx, error)) (entity.Task, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	task, err := t.Get(ctx, selectParam)
+	if err != nil {
+		return task, err
+	}
+
+	if task.TaskStatus == status {
+		return task, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("task %d is already %s", task.ID, status))
+	}
+
+	if err := t.validateStatusChange(ctx, task, status); err != nil {
+		return task, err
+	}
+
+	updateParam := entity.UpdateTaskParam{
+		TaskStatus: status,
+		UpdatedAt:  null.TimeFrom(Now()),
+		UpdatedBy:  null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	history := statusHistory(task.ID, task.TaskStatus, status, user.User.ID)
+	if err := t.task.UpdateStatus(ctx, updateParam, entity.TaskParam{ID: null.Int64From(task.ID), TaskStatus: task.TaskStatus}, history, fn); err != nil {
+		return task, err
+	}
+
+	if err := t.onStatusChanged(ctx, task, status, user.User.ID); err != nil {
+		return task, err
+	}
+
+	task.TaskStatus = status
+	task.UpdatedAt = updateParam.UpdatedAt
+	task.UpdatedBy = updateParam.UpdatedBy
+
+	return task, nil
+}
+
+// onStatusChanged run the side effects of the committed status change, the completed task is rewarded, spawns its next
+// occurrence and may complete its parent
+func (t *task) onStatusChanged(ctx context.Context, task entity.Task, to string, userID int64) error {
+	if to != entity.TaskStatusDone || task.TaskStatus == entity.TaskStatusDone {
+		return nil
+	}
+
+	if err := t.rewardCompletion(ctx, task, userID); err != nil {
+		return err
+	}
+
+	// Recurring task will spawn its next occurrence when it is done
+	if isRecurring(task) {
+		if err := t.spawnNextOccurrence(ctx, task, userID); err != nil {
+			return err
+		}
+	}
+
+	if task.ParentID.Valid {
+		if err := t.autoCompleteParent(ctx, task.ParentID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// statusHistory return the record of the transition of the task
+func statusHistory(taskID int64, from, to string, userID int64) entity.CreateTaskStatusHistoryParam {
+	return entity.CreateTaskStatusHistoryParam{
+		TaskID:     taskID,
+		FromStatus: from,
+		ToStatus:   to,
+		CreatedBy:  null.StringFrom(fmt.Sprintf("%v", userID)),
+	}
+}
+
+// recordStatusChange write the transition into the task status history
+func (t *task) recordStatusChange(ctx context.Context, taskID int64, from, to string, userID int64) error {
+	_, err := t.taskStatusHistory.Create(ctx, statusHistory(taskID, from, to, userID))
+
+	return err
+}
+
+func (t *task) GetStatusHistory(ctx context.Context, params entity.TaskStatusHistoryParam) ([]entity.TaskStatusHistory, *entity.Pagination, error) {
+	task, err := t.Get(ctx, entity.TaskParam{ID: params.TaskID})
+	if err != nil {
+		return []entity.TaskStatusHistory{}, &entity.Pagination{}, err
+	}
+
+	params.TaskID = null.Int64From(task.ID)
+	params.IncludePagination = true
+	params.QueryOption = query.Option{IsActive: true}
+
+	// Oldest transition first so the cycle time can be read from top to bottom
+	params.SortBy = []string{"id"}
+
+	return t.taskStatusHistory.GetList(ctx, params)
+}
@@ -0,0 +1,184 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// parseTagFilter parse the tags filter into its mode and tag names, the mode is any when it is not stated
+func parseTagFilter(value string) (string, []string, error) {
+	mode := entity.TagFilterAny
+	if i := strings.Index(value, ":"); i >= 0 {
+		mode = strings.ToLower(strings.TrimSpace(value[:i]))
+		value = value[i+1:]
+	}
+
+	if mode != entity.TagFilterAny && mode != entity.TagFilterAll {
+		return "", nil, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid tags filter mode %q, must be any or all", mode))
+	}
+
+	names := []string{}
+	seen := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		names = append(names, name)
+	}
+
+	if len(names) < 1 {
+		return "", nil, errors.NewWithCode(codes.CodeBadRequest, "tags filter must contain at least one tag name")
+	}
+
+	return mode, names, nil
+}
+
+// filterByTags narrow down the task ids to the tasks matching the tags filter, false means there is no matching task
+func (t *task) filterByTags(ctx context.Context, params *entity.TaskParam) (bool, error) {
+	mode, names, err := parseTagFilter(params.Tags)
+	if err != nil {
+		return false, err
+	}
+
+	tags, _, err := t.tag.GetList(ctx, entity.TagParam{
+		UserID: params.UserId,
+		Names:  names,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// Admin can see tags of several users with the same name, so the tags are matched by name
+	tagNames := map[int64]string{}
+	tagIDs := []int64{}
+	for _, tag := range tags {
+		tagNames[tag.ID] = strings.ToLower(tag.Name)
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	if len(tagIDs) < 1 {
+		return false, nil
+	}
+
+	taskTags, _, err := t.taskTag.GetList(ctx, entity.TaskTagParam{
+		TagIDs: tagIDs,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	matchedNames := map[int64]map[string]bool{}
+	for _, tt := range taskTags {
+		if matchedNames[tt.TaskID] == nil {
+			matchedNames[tt.TaskID] = map[string]bool{}
+		}
+		matchedNames[tt.TaskID][tagNames[tt.TagID]] = true
+	}
+
+	// Keep the ids which are already requested
+	requestedIDs := map[int64]bool{}
+	for _, id := range params.IDs {
+		requestedIDs[id] = true
+	}
+
+	taskIDs := []int64{}
+	for taskID, matched := range matchedNames {
+		if mode == entity.TagFilterAll && len(matched) < len(names) {
+			continue
+		}
+
+		if len(requestedIDs) > 0 && !requestedIDs[taskID] {
+			continue
+		}
+
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	if len(taskIDs) < 1 {
+		return false, nil
+	}
+
+	params.IDs = taskIDs
+
+	return true, nil
+}
+
+// populateTags fill the active tags of each task
+func (t *task) populateTags(ctx context.Context, tasks []entity.Task) error {
+	if len(tasks) < 1 {
+		return nil
+	}
+
+	taskIDs := []int64{}
+	for i := range tasks {
+		tasks[i].Tags = []entity.Tag{}
+		taskIDs = append(taskIDs, tasks[i].ID)
+	}
+
+	taskTags, _, err := t.taskTag.GetList(ctx, entity.TaskTagParam{
+		TaskIDs: taskIDs,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(taskTags) < 1 {
+		return nil
+	}
+
+	tagIDs := []int64{}
+	for _, tt := range taskTags {
+		tagIDs = append(tagIDs, tt.TagID)
+	}
+
+	tags, _, err := t.tag.GetList(ctx, entity.TagParam{
+		IDs: tagIDs,
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	tagByID := map[int64]entity.Tag{}
+	for _, tag := range tags {
+		tagByID[tag.ID] = tag
+	}
+
+	tagsByTaskID := map[int64][]entity.Tag{}
+	for _, tt := range taskTags {
+		if tag, ok := tagByID[tt.TagID]; ok {
+			tagsByTaskID[tt.TaskID] = append(tagsByTaskID[tt.TaskID], tag)
+		}
+	}
+
+	for i := range tasks {
+		if tags, ok := tagsByTaskID[tasks[i].ID]; ok {
+			tasks[i].Tags = tags
+		}
+	}
+
+	return nil
+}
@@ -3,13 +3,28 @@ package task
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
+	categoryDom "github.com/adiatma85/gg-project/src/business/domain/category"
+	tagDom "github.com/adiatma85/gg-project/src/business/domain/tag"
 	taskDom "github.com/adiatma85/gg-project/src/business/domain/task"
+	taskDependencyDom "github.com/adiatma85/gg-project/src/business/domain/taskdependency"
+	taskReminderDom "github.com/adiatma85/gg-project/src/business/domain/taskreminder"
+	taskStatusHistoryDom "github.com/adiatma85/gg-project/src/business/domain/taskstatushistory"
+	taskTagDom "github.com/adiatma85/gg-project/src/business/domain/tasktag"
+	userDom "github.com/adiatma85/gg-project/src/business/domain/user"
 	"github.com/adiatma85/gg-project/src/business/entity"
+	achievementUc "github.com/adiatma85/gg-project/src/business/usecase/achievement"
+	progressUc "github.com/adiatma85/gg-project/src/business/usecase/progress"
+	workspaceUc "github.com/adiatma85/gg-project/src/business/usecase/workspace"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
 	"github.com/adiatma85/own-go-sdk/jwtAuth"
 	"github.com/adiatma85/own-go-sdk/log"
 	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+	"github.com/adiatma85/own-go-sdk/sql"
 )
 
 type Interface interface {
@@ -18,27 +33,98 @@ type Interface interface {
 	GetList(ctx context.Context, params entity.TaskParam) ([]entity.Task, *entity.Pagination, error)
 	Update(ctx context.Context, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam) error
 	Delete(ctx context.Context, selectParam entity.TaskParam) error
+	Bulk(ctx context.Context, param entity.BulkTaskParam) ([]entity.BulkTaskResult, error)
+	Export(ctx context.Context, params entity.TaskParam, fn func(task entity.Task, category string) error) error
+	Import(ctx context.Context, param entity.TaskImportParam, fileName string, r io.Reader) (entity.TaskImportResult, error)
+	GetOccurrences(ctx context.Context, params entity.TaskOccurrenceParam) ([]entity.TaskOccurrence, error)
+	CreateSubtask(ctx context.Context, parentParam entity.TaskParam, req entity.CreateTaskParam) (entity.Task, error)
+	GetSubtasks(ctx context.Context, params entity.TaskParam) ([]entity.Task, *entity.Pagination, error)
+	GetBlockers(ctx context.Context, params entity.TaskParam) ([]entity.Task, error)
+	AddBlocker(ctx context.Context, params entity.TaskParam, req entity.CreateTaskDependencyParam) (entity.TaskDependency, error)
+	RemoveBlocker(ctx context.Context, params entity.TaskDependencyParam) error
+	GetStatusHistory(ctx context.Context, params entity.TaskStatusHistoryParam) ([]entity.TaskStatusHistory, *entity.Pagination, error)
+	Assign(ctx context.Context, selectParam entity.TaskParam, req entity.AssignTaskParam) (entity.Task, error)
+	Unassign(ctx context.Context, selectParam entity.TaskParam) (entity.Task, error)
+	Move(ctx context.Context, selectParam entity.TaskParam, req entity.MoveTaskParam) (entity.Task, error)
+	CanManage(ctx context.Context, task entity.Task) (bool, error)
+	ChangeStatus(ctx context.Context, selectParam entity.TaskParam, status string, fn func(tx sql.CommandTx) (sql.CommandTx, error)) (entity.Task, error)
+}
+
+// CalendarInterface read the tasks of the user given by the caller instead of the context, it is only given to the
+// calendar feed which authenticates the user with its own token
+type CalendarInterface interface {
+	GetCalendarEvents(ctx context.Context, params entity.TaskCalendarParam) ([]entity.TaskCalendarEvent, error)
+}
+
+type Config struct {
+	AllowReopen      bool // Allow done task to go back to todo or ongoing
+	AllowSkipOngoing bool // Allow todo task to be done directly
 }
 
 type InitParam struct {
-	Log     log.Interface
-	Task    taskDom.Interface
-	JwtAuth jwtAuth.Interface
+	Log               log.Interface
+	Task              taskDom.Interface
+	TaskDependency    taskDependencyDom.Interface
+	TaskStatusHistory taskStatusHistoryDom.Interface
+	Tag               tagDom.Interface
+	TaskTag           taskTagDom.Interface
+	TaskReminder      taskReminderDom.Interface
+	Category          categoryDom.Interface
+	User              userDom.Interface
+	Progress          progressUc.Interface
+	Achievement       achievementUc.Interface
+	Workspace         workspaceUc.Interface
+	JwtAuth           jwtAuth.Interface
+	Conf              Config
 }
 
 type task struct {
-	log     log.Interface
-	task    taskDom.Interface
-	jwtAuth jwtAuth.Interface
+	log               log.Interface
+	task              taskDom.Interface
+	taskDependency    taskDependencyDom.Interface
+	taskStatusHistory taskStatusHistoryDom.Interface
+	tag               tagDom.Interface
+	taskTag           taskTagDom.Interface
+	taskReminder      taskReminderDom.Interface
+	category          categoryDom.Interface
+	user              userDom.Interface
+	progress          progressUc.Interface
+	achievement       achievementUc.Interface
+	workspace         workspaceUc.Interface
+	jwtAuth           jwtAuth.Interface
+	conf              Config
 }
 
 var Now = time.Now
 
+// Maximum number of occurrences returned in one expansion
+const maxOccurrences = 1000
+
 func Init(param InitParam) Interface {
+	return newTask(param)
+}
+
+// InitCalendar return the task usecase of the calendar feed, it shares the rules of Init
+func InitCalendar(param InitParam) CalendarInterface {
+	return newTask(param)
+}
+
+func newTask(param InitParam) *task {
 	t := &task{
-		log:     param.Log,
-		task:    param.Task,
-		jwtAuth: param.JwtAuth,
+		log:               param.Log,
+		task:              param.Task,
+		taskDependency:    param.TaskDependency,
+		taskStatusHistory: param.TaskStatusHistory,
+		tag:               param.Tag,
+		taskTag:           param.TaskTag,
+		taskReminder:      param.TaskReminder,
+		category:          param.Category,
+		user:              param.User,
+		progress:          param.Progress,
+		achievement:       param.Achievement,
+		workspace:         param.Workspace,
+		jwtAuth:           param.JwtAuth,
+		conf:              param.Conf,
 	}
 
 	return t
@@ -50,11 +136,77 @@ func (t *task) Create(ctx context.Context, req entity.CreateTaskParam) (entity.T
 		return entity.Task{}, err
 	}
 
+	if req.RRule != "" {
+		req.RRule, err = validateRRule(req.RRule)
+		if err != nil {
+			return entity.Task{}, err
+		}
+	}
+
+	if req.TaskStatus == "" {
+		req.TaskStatus = entity.TaskStatusTodo
+	} else if !isValidStatus(req.TaskStatus) {
+		return entity.Task{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid task status %q, must be one of todo, ongoing, done", req.TaskStatus))
+	}
+
 	req.UserId = user.User.ID
+
+	// Subtask always belongs to the owner and the workspace of its parent, it is assigned to the assignee of the parent
+	// unless the managers of the parent assign it to someone else, so the assignee who creates it can still access it
+	if req.ParentID.Valid {
+		parent, err := t.Get(ctx, entity.TaskParam{ID: req.ParentID})
+		if err != nil {
+			return entity.Task{}, err
+		}
+		req.UserId = parent.UserId
+		req.WorkspaceID = parent.WorkspaceID
+
+		if !req.AssigneeID.Valid {
+			req.AssigneeID = parent.AssigneeID
+		} else if req.AssigneeID != parent.AssigneeID {
+			if canManage, err := t.canManageTask(ctx, parent, user.User.ID); err != nil {
+				return entity.Task{}, err
+			} else if !canManage {
+				return entity.Task{}, errors.NewWithCode(codes.CodeForbidden, "only the owner can assign the subtask to someone else")
+			}
+		}
+	} else if req.WorkspaceID.Valid {
+		if _, err := t.workspace.Authorize(ctx, req.WorkspaceID.Int64, user.User.ID); err != nil {
+			return entity.Task{}, err
+		}
+	}
+
+	if req.CategoryID > 0 {
+		if err := t.validateCategory(ctx, req.CategoryID, req.WorkspaceID); err != nil {
+			return entity.Task{}, err
+		}
+	}
+
+	if req.AssigneeID.Valid {
+		if err := t.validateAssignee(ctx, req.AssigneeID.Int64, req.WorkspaceID); err != nil {
+			return entity.Task{}, err
+		}
+	}
+
+	// New task is placed after the last task of its owner or its workspace
+	req.Position, err = t.getNextPosition(ctx, req.UserId, req.WorkspaceID)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
 	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
 	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
 
-	return t.task.Create(ctx, req)
+	task, err := t.task.Create(ctx, req)
+	if err != nil {
+		return task, err
+	}
+
+	if err := t.recordStatusChange(ctx, task.ID, "", task.TaskStatus, user.User.ID); err != nil {
+		return task, err
+	}
+
+	return task, nil
 }
 
 func (t *task) Get(ctx context.Context, params entity.TaskParam) (entity.Task, error) {
@@ -65,26 +217,34 @@ func (t *task) Get(ctx context.Context, params entity.TaskParam) (entity.Task, e
 		return entity.Task{}, err
 	}
 
-	// If the user id is not admin, then filter for that user
-	if user.User.ID != entity.RoleIdSuperAdmin {
-		params.UserId = null.Int64From(user.User.ID)
+	if err := t.applyAccessFilter(ctx, &params, user.User.ID); err != nil {
+		return entity.Task{}, err
+	}
+
+	task, err := t.task.Get(ctx, params)
+	if err != nil {
+		return task, err
 	}
 
-	return t.task.Get(ctx, params)
+	tasks := []entity.Task{task}
+	if err := t.populateTags(ctx, tasks); err != nil {
+		return task, err
+	}
+
+	return tasks[0], nil
 }
 
 func (t *task) GetList(ctx context.Context, params entity.TaskParam) ([]entity.Task, *entity.Pagination, error) {
 	params.IncludePagination = true
 	params.QueryOption.IsActive = true
 
-	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	isMatched, err := t.applyListFilter(ctx, &params)
 	if err != nil {
-		return []entity.Task{}, &entity.Pagination{}, err
-	}
-
-	// If the user id is not admin, then filter for that user
-	if user.User.ID != entity.RoleIdSuperAdmin {
-		params.UserId = null.Int64From(user.User.ID)
+		return nil, nil, err
+	} else if !isMatched {
+		pg := entity.Pagination{CurrentPage: params.Page}
+		pg.ProcessPagination(params.Limit)
+		return []entity.Task{}, &pg, nil
 	}
 
 	tasks, pg, err := t.task.GetList(ctx, params)
@@ -92,19 +252,119 @@ func (t *task) GetList(ctx context.Context, params entity.TaskParam) ([]entity.T
 		return nil, nil, err
 	}
 
+	if err := t.populateTags(ctx, tasks); err != nil {
+		return nil, nil, err
+	}
+
 	return tasks, pg, nil
 }
 
+// applyListFilter restrict the list to the tasks of the user and resolve the filters which need another lookup,
+// it returns false when no task can match the filters
+func (t *task) applyListFilter(ctx context.Context, params *entity.TaskParam) (bool, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := t.applyAccessFilter(ctx, params, user.User.ID); err != nil {
+		return false, err
+	}
+
+	if params.AssignedToMe {
+		params.AssigneeID = null.Int64From(user.User.ID)
+	}
+
+	// Overdue task is the unfinished task which due time is already passed
+	if params.Overdue {
+		now := Now()
+		if !params.DueTimeLT.Valid || now.Before(params.DueTimeLT.Time) {
+			params.DueTimeLT = null.TimeFrom(now)
+		}
+		params.TaskStatusNE = entity.TaskStatusDone
+	}
+
+	if params.Tags != "" {
+		return t.filterByTags(ctx, params)
+	}
+
+	return true, nil
+}
+
 func (t *task) Update(ctx context.Context, updateParam entity.UpdateTaskParam, selectParam entity.TaskParam) error {
 	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
 	if err != nil {
 		return err
 	}
 
+	if updateParam.RRule.Valid && updateParam.RRule.String != "" {
+		updateParam.RRule.String, err = validateRRule(updateParam.RRule.String)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Get the current state of the task first, this will also make sure the task belongs to the user
+	task, err := t.Get(ctx, selectParam)
+	if err != nil {
+		return err
+	}
+
+	// Assignee and the workspace members work on the task, only its managers can move it to another category
+	if updateParam.CategoryID.Valid && updateParam.CategoryID != task.CategoryID {
+		canManage, err := t.canManageTask(ctx, task, user.User.ID)
+		if err != nil {
+			return err
+		} else if !canManage {
+			return errors.NewWithCode(codes.CodeForbidden, "only the owner can change the category of the task")
+		}
+
+		if err := t.validateCategory(ctx, updateParam.CategoryID.Int64, task.WorkspaceID); err != nil {
+			return err
+		}
+	}
+
+	if updateParam.TaskStatus != "" {
+		if err := t.validateStatusChange(ctx, task, updateParam.TaskStatus); err != nil {
+			return err
+		}
+	}
+
 	updateParam.UpdatedAt = null.TimeFrom(Now())
 	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
 
-	return t.task.Update(ctx, updateParam, selectParam)
+	// Status change only applies to the status it was validated against, so two concurrent changes can not both
+	// complete the task and spawn or reward it twice
+	if updateParam.TaskStatus != "" && updateParam.TaskStatus != task.TaskStatus {
+		history := statusHistory(task.ID, task.TaskStatus, updateParam.TaskStatus, user.User.ID)
+		err = t.task.UpdateStatus(ctx, updateParam, entity.TaskParam{ID: null.Int64From(task.ID), TaskStatus: task.TaskStatus}, history, nil)
+	} else {
+		err = t.task.Update(ctx, updateParam, entity.TaskParam{ID: null.Int64From(task.ID)})
+	}
+	if err != nil {
+		return err
+	}
+
+	if updateParam.Periodic.Valid {
+		task.Periodic = updateParam.Periodic.String
+	}
+
+	if updateParam.RRule.Valid {
+		task.RRule = updateParam.RRule.String
+	}
+
+	if updateParam.DueTime.Valid && !updateParam.DueTime.Time.Equal(task.DueTime.Time) {
+		if err := t.rescheduleReminders(ctx, task.ID, updateParam.DueTime.Time, user.User.ID); err != nil {
+			return err
+		}
+		task.DueTime = updateParam.DueTime
+	}
+
+	if updateParam.TaskStatus != "" {
+		return t.onStatusChanged(ctx, task, updateParam.TaskStatus, user.User.ID)
+	}
+
+	return nil
 }
 
 func (t *task) Delete(ctx context.Context, selectParam entity.TaskParam) error {
@@ -113,11 +373,187 @@ func (t *task) Delete(ctx context.Context, selectParam entity.TaskParam) error {
 		return err
 	}
 
+	task, err := t.Get(ctx, selectParam)
+	if err != nil {
+		return err
+	}
+
+	if canManage, err := t.canManageTask(ctx, task, user.User.ID); err != nil {
+		return err
+	} else if !canManage {
+		return errors.NewWithCode(codes.CodeForbidden, "only the owner can delete the task")
+	}
+
+	// Subtasks are deleted together with their parent, otherwise they are left behind without a parent
+	ids, err := t.getSubtaskTree(ctx, task.ID)
+	if err != nil {
+		return err
+	}
+
 	deleteParam := entity.UpdateTaskParam{
 		Status:    null.Int64From(-1),
 		DeletedAt: null.TimeFrom(Now()),
 		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
 	}
 
-	return t.task.Update(ctx, deleteParam, selectParam)
+	return t.task.Update(ctx, deleteParam, entity.TaskParam{IDs: ids})
+}
+
+// getSubtaskTree walk the subtasks breadth first and return the id of the task and all of its active subtasks
+func (t *task) getSubtaskTree(ctx context.Context, taskID int64) ([]int64, error) {
+	visited := map[int64]bool{taskID: true}
+	ids := []int64{taskID}
+	frontier := []int64{taskID}
+
+	for len(frontier) > 0 {
+		subtasks, _, err := t.task.GetList(ctx, entity.TaskParam{
+			ParentIDs: frontier,
+			QueryOption: query.Option{
+				IsActive:     true,
+				DisableLimit: true,
+			},
+		})
+		if err != nil {
+			return ids, err
+		}
+
+		frontier = []int64{}
+		for _, s := range subtasks {
+			if !visited[s.ID] {
+				visited[s.ID] = true
+				ids = append(ids, s.ID)
+				frontier = append(frontier, s.ID)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+func (t *task) GetOccurrences(ctx context.Context, params entity.TaskOccurrenceParam) ([]entity.TaskOccurrence, error) {
+	result := []entity.TaskOccurrence{}
+
+	task, err := t.Get(ctx, entity.TaskParam{ID: null.Int64From(params.ID)})
+	if err != nil {
+		return result, err
+	}
+
+	// Default window is one month starting from today
+	from, to := params.From, params.To
+	if from.IsZero() {
+		now := Now()
+		from = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}
+
+	if to.IsZero() {
+		to = from.AddDate(0, 1, 0)
+	} else {
+		// The end date is inclusive
+		to = to.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	if to.Before(from) {
+		return result, errors.NewWithCode(codes.CodeBadRequest, "to must be after from")
+	}
+
+	occurrences, err := t.occurrencesBetween(ctx, task, from, to)
+	if err != nil {
+		return result, err
+	}
+
+	for _, o := range occurrences {
+		result = append(result, entity.TaskOccurrence{
+			TaskID:  task.ID,
+			DueTime: o,
+		})
+	}
+
+	return result, nil
+}
+
+// validateRRule return the normalized rule if it is valid
+func validateRRule(value string) (string, error) {
+	value = normalizeRRule(value)
+	if _, err := parseRRule(value); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+func (t *task) CreateSubtask(ctx context.Context, parentParam entity.TaskParam, req entity.CreateTaskParam) (entity.Task, error) {
+	parent, err := t.Get(ctx, parentParam)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	req.ParentID = null.Int64From(parent.ID)
+
+	return t.Create(ctx, req)
+}
+
+func (t *task) GetSubtasks(ctx context.Context, params entity.TaskParam) ([]entity.Task, *entity.Pagination, error) {
+	parent, err := t.Get(ctx, entity.TaskParam{ID: params.ID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params.ID = null.Int64{}
+	params.ParentID = null.Int64From(parent.ID)
+
+	return t.GetList(ctx, params)
+}
+
+// rewardCompletion award the XP of the done task and unlock the achievements which are reached with it
+func (t *task) rewardCompletion(ctx context.Context, task entity.Task, userID int64) error {
+	progress, err := t.progress.Award(ctx, task, userID)
+	if err != nil {
+		return err
+	}
+
+	// Achievement is a bonus, failing to evaluate it does not fail the task update
+	if _, err := t.achievement.Evaluate(ctx, entity.AchievementEvent{
+		Type:     entity.AchievementEventTaskDone,
+		UserID:   userID,
+		Task:     &task,
+		Progress: &progress,
+	}); err != nil {
+		t.log.Error(ctx, fmt.Sprintf("failed to evaluate achievements of user %d: %v", userID, err))
+	}
+
+	return nil
+}
+
+// autoCompleteParent mark the parent as done when it opt in and all of its subtasks are done
+func (t *task) autoCompleteParent(ctx context.Context, parentID null.Int64) error {
+	parent, err := t.task.Get(ctx, entity.TaskParam{
+		ID: parentID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !parent.AutoComplete || parent.TaskStatus == entity.TaskStatusDone || parent.SubtaskDoneCount < parent.SubtaskCount {
+		return nil
+	}
+
+	// Parent which is still blocked stay open, it will be completed manually once its blockers are done
+	if err := t.checkBlockers(ctx, parent.ID); err != nil {
+		t.log.Info(ctx, fmt.Sprintf("skip auto complete of task %d: %v", parent.ID, err))
+		return nil
+	}
+
+	// Parent which is not started yet goes through ongoing when todo task can not be done directly
+	if err := t.validateTransition(parent.TaskStatus, entity.TaskStatusDone); err != nil {
+		if err := t.Update(ctx, entity.UpdateTaskParam{TaskStatus: entity.TaskStatusOnGoing}, entity.TaskParam{ID: parentID}); err != nil {
+			return err
+		}
+	}
+
+	return t.Update(ctx, entity.UpdateTaskParam{TaskStatus: entity.TaskStatusDone}, entity.TaskParam{ID: parentID})
 }
@@ -0,0 +1,83 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+// applyAccessFilter restrict the tasks to the ones owned by or assigned to the user and the ones shared in the
+// workspaces of the user, admin can access every task
+func (t *task) applyAccessFilter(ctx context.Context, params *entity.TaskParam, userID int64) error {
+	if entity.IsSuperAdmin(ctx, userID) {
+		return nil
+	}
+
+	workspaceIDs, err := t.workspace.GetWorkspaceIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	params.AccessUserID = null.Int64From(userID)
+	params.AccessWorkspaceIDs = workspaceIDs
+
+	return nil
+}
+
+// CanManage return true when the user can manage the task, see canManageTask
+func (t *task) CanManage(ctx context.Context, task entity.Task) (bool, error) {
+	user, err := t.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return t.canManageTask(ctx, task, user.User.ID)
+}
+
+// canManageTask return true when the user can delete, assign or move the task to another category, that is the
+// owner of the task or the owner and the admins of its workspace
+func (t *task) canManageTask(ctx context.Context, task entity.Task, userID int64) (bool, error) {
+	if isTaskOwner(ctx, task, userID) {
+		return true, nil
+	}
+
+	if !task.WorkspaceID.Valid {
+		return false, nil
+	}
+
+	_, err := t.workspace.Authorize(ctx, task.WorkspaceID.Int64, userID, entity.WorkspaceRoleOwner, entity.WorkspaceRoleAdmin)
+	if errors.GetCode(err) == codes.CodeForbidden {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateCategory make sure the category can be used by the task, the global category can be used everywhere and
+// the category of a workspace only by the tasks of the same workspace
+func (t *task) validateCategory(ctx context.Context, categoryID int64, workspaceID null.Int64) error {
+	category, err := t.category.Get(ctx, entity.CategoryParam{
+		ID: null.Int64From(categoryID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("category %d does not exist", categoryID))
+	} else if err != nil {
+		return err
+	}
+
+	if category.WorkspaceID.Valid && category.WorkspaceID != workspaceID {
+		return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("category %d belongs to another workspace", categoryID))
+	}
+
+	return nil
+}
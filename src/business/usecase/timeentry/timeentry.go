@@ -0,0 +1,386 @@
+package timeentry
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	categoryDom "github.com/adiatma85/gg-project/src/business/domain/category"
+	taskDom "github.com/adiatma85/gg-project/src/business/domain/task"
+	timeEntryDom "github.com/adiatma85/gg-project/src/business/domain/timeentry"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	taskUc "github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type Interface interface {
+	Start(ctx context.Context, taskParam entity.TaskParam, req entity.StartTimerParam) (entity.TimeEntry, error)
+	Stop(ctx context.Context, taskParam entity.TaskParam) (entity.TimeEntry, error)
+	GetRunning(ctx context.Context) (entity.TimeEntry, error)
+	Create(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTimeEntryParam) (entity.TimeEntry, error)
+	GetList(ctx context.Context, params entity.TimeEntryParam) ([]entity.TimeEntry, *entity.Pagination, error)
+	Delete(ctx context.Context, selectParam entity.TimeEntryParam) error
+	GetTotal(ctx context.Context, params entity.TimeEntryTotalParam) ([]entity.TimeEntryTotal, error)
+}
+
+type InitParam struct {
+	Log       log.Interface
+	TimeEntry timeEntryDom.Interface
+	TaskDom   taskDom.Interface
+	Category  categoryDom.Interface
+	Task      taskUc.Interface
+	JwtAuth   jwtAuth.Interface
+}
+
+type timeEntry struct {
+	log       log.Interface
+	timeEntry timeEntryDom.Interface
+	taskDom   taskDom.Interface
+	category  categoryDom.Interface
+	task      taskUc.Interface
+	jwtAuth   jwtAuth.Interface
+}
+
+var Now = time.Now
+
+const (
+	// Longest manual entry, longer work should be split into several entries
+	maxEntryDuration = 24 * time.Hour
+
+	// Note is stored in a VARCHAR(255) column
+	maxNoteLength = 255
+
+	// Default and longest range of the totals
+	defaultTotalRange = 30 * 24 * time.Hour
+	maxTotalRange     = 366 * 24 * time.Hour
+)
+
+func Init(param InitParam) Interface {
+	te := &timeEntry{
+		log:       param.Log,
+		timeEntry: param.TimeEntry,
+		taskDom:   param.TaskDom,
+		category:  param.Category,
+		task:      param.Task,
+		jwtAuth:   param.JwtAuth,
+	}
+
+	return te
+}
+
+// Start run a new timer on the task, the user can only have one running timer at a time
+func (te *timeEntry) Start(ctx context.Context, taskParam entity.TaskParam, req entity.StartTimerParam) (entity.TimeEntry, error) {
+	user, err := te.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	if len(req.Note) > maxNoteLength {
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("note must not be longer than %d characters", maxNoteLength))
+	}
+
+	// Make sure the task is accessible by the user
+	task, err := te.task.Get(ctx, entity.TaskParam{ID: taskParam.ID})
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	running, err := te.getRunning(ctx, user.User.ID)
+	if err == nil {
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("timer is already running on task %d, stop it first", running.TaskID))
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return entity.TimeEntry{}, err
+	}
+
+	// The unique running index of the table rejects a second timer started at the same moment
+	return te.timeEntry.Create(ctx, entity.CreateTimeEntryParam{
+		TaskID:    task.ID,
+		UserID:    user.User.ID,
+		StartedAt: null.TimeFrom(Now()),
+		Note:      req.Note,
+		CreatedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+		UpdatedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	})
+}
+
+// Stop end the running timer of the user on the task
+func (te *timeEntry) Stop(ctx context.Context, taskParam entity.TaskParam) (entity.TimeEntry, error) {
+	user, err := te.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	running, err := te.getRunning(ctx, user.User.ID)
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist || (err == nil && running.TaskID != taskParam.ID.Int64) {
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeNotFound, "no running timer on the task")
+	} else if err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	endedAt := Now()
+	updateParam := entity.UpdateTimeEntryParam{
+		EndedAt:         null.TimeFrom(endedAt),
+		DurationSeconds: null.Int64From(durationSeconds(running.StartedAt.Time, endedAt)),
+		UpdatedAt:       null.TimeFrom(endedAt),
+		UpdatedBy:       null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	if err := te.timeEntry.Update(ctx, updateParam, entity.TimeEntryParam{ID: null.Int64From(running.ID)}); err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	return te.timeEntry.Get(ctx, entity.TimeEntryParam{ID: null.Int64From(running.ID)})
+}
+
+func (te *timeEntry) GetRunning(ctx context.Context) (entity.TimeEntry, error) {
+	user, err := te.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	running, err := te.getRunning(ctx, user.User.ID)
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeNotFound, "no running timer")
+	}
+
+	return running, err
+}
+
+func (te *timeEntry) getRunning(ctx context.Context, userID int64) (entity.TimeEntry, error) {
+	return te.timeEntry.Get(ctx, entity.TimeEntryParam{
+		UserID:  null.Int64From(userID),
+		Running: true,
+		Status:  null.Int64From(1),
+	})
+}
+
+// Create add a finished entry for the time which was not tracked with the timer
+func (te *timeEntry) Create(ctx context.Context, taskParam entity.TaskParam, req entity.CreateTimeEntryParam) (entity.TimeEntry, error) {
+	user, err := te.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	switch {
+	case !req.StartedAt.Valid || !req.EndedAt.Valid:
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeBadRequest, "startedAt and endedAt are required")
+	case !req.EndedAt.Time.After(req.StartedAt.Time):
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeBadRequest, "endedAt must be after startedAt")
+	case req.EndedAt.Time.Sub(req.StartedAt.Time) > maxEntryDuration:
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("time entry must not be longer than %v", maxEntryDuration))
+	case req.EndedAt.Time.After(Now()):
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeBadRequest, "endedAt must not be in the future")
+	case len(req.Note) > maxNoteLength:
+		return entity.TimeEntry{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("note must not be longer than %d characters", maxNoteLength))
+	}
+
+	// Make sure the task is accessible by the user
+	task, err := te.task.Get(ctx, entity.TaskParam{ID: taskParam.ID})
+	if err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	if err := te.checkOverlap(ctx, user.User.ID, req.StartedAt.Time, req.EndedAt.Time); err != nil {
+		return entity.TimeEntry{}, err
+	}
+
+	req.TaskID = task.ID
+	req.UserID = user.User.ID
+	req.DurationSeconds = durationSeconds(req.StartedAt.Time, req.EndedAt.Time)
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return te.timeEntry.Create(ctx, req)
+}
+
+// checkOverlap return conflict error when the time is already tracked by another entry of the user, including the
+// running timer which is counted until now
+func (te *timeEntry) checkOverlap(ctx context.Context, userID int64, startedAt, endedAt time.Time) error {
+	overlap, err := te.timeEntry.Get(ctx, entity.TimeEntryParam{
+		UserID:      null.Int64From(userID),
+		StartedAtLT: null.TimeFrom(endedAt),
+		EndedAtGT:   null.TimeFrom(startedAt),
+		Status:      null.Int64From(1),
+	})
+	if err == nil {
+		return errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("time entry overlaps time entry %d on task %d", overlap.ID, overlap.TaskID))
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return err
+	}
+
+	running, err := te.getRunning(ctx, userID)
+	if err == nil && running.StartedAt.Time.Before(endedAt) {
+		return errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("time entry overlaps the running timer on task %d", running.TaskID))
+	} else if err != nil && errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return err
+	}
+
+	return nil
+}
+
+func (te *timeEntry) GetList(ctx context.Context, params entity.TimeEntryParam) ([]entity.TimeEntry, *entity.Pagination, error) {
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	// Make sure the task is accessible by the user
+	task, err := te.task.Get(ctx, entity.TaskParam{ID: params.TaskID})
+	if err != nil {
+		return []entity.TimeEntry{}, &entity.Pagination{}, err
+	}
+
+	params.TaskID = null.Int64From(task.ID)
+	params.SortBy = []string{"-started_at"}
+
+	return te.timeEntry.GetList(ctx, params)
+}
+
+func (te *timeEntry) Delete(ctx context.Context, selectParam entity.TimeEntryParam) error {
+	user, err := te.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Make sure the task is accessible by the user
+	task, err := te.task.Get(ctx, entity.TaskParam{ID: selectParam.TaskID})
+	if err != nil {
+		return err
+	}
+
+	timeEntry, err := te.timeEntry.Get(ctx, entity.TimeEntryParam{
+		ID:     selectParam.ID,
+		TaskID: null.Int64From(task.ID),
+		Status: null.Int64From(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	// The user who tracked the time and the managers of the task can delete the entry
+	if timeEntry.UserID != user.User.ID {
+		if canManage, err := te.task.CanManage(ctx, task); err != nil {
+			return err
+		} else if !canManage {
+			return errors.NewWithCode(codes.CodeForbidden, "only the owner of the time entry can delete it")
+		}
+	}
+
+	deleteParam := entity.UpdateTimeEntryParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}
+
+	return te.timeEntry.Update(ctx, deleteParam, entity.TimeEntryParam{ID: null.Int64From(timeEntry.ID)})
+}
+
+// GetTotal sum the time spent by the user per task, category or day. The running timer is counted until now
+func (te *timeEntry) GetTotal(ctx context.Context, params entity.TimeEntryTotalParam) ([]entity.TimeEntryTotal, error) {
+	user, err := te.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return []entity.TimeEntryTotal{}, err
+	}
+
+	switch params.GroupBy {
+	case "":
+		params.GroupBy = entity.TimeEntryGroupByTask
+	case entity.TimeEntryGroupByTask, entity.TimeEntryGroupByCategory, entity.TimeEntryGroupByDay:
+	default:
+		return []entity.TimeEntryTotal{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid groupBy %q, must be one of task, category, day", params.GroupBy))
+	}
+
+	params.Now = Now()
+	if params.To.IsZero() {
+		params.To = params.Now
+	}
+
+	if params.From.IsZero() {
+		params.From = params.To.Add(-defaultTotalRange)
+	}
+
+	if !params.To.After(params.From) {
+		return []entity.TimeEntryTotal{}, errors.NewWithCode(codes.CodeBadRequest, "to must be after from")
+	} else if params.To.Sub(params.From) > maxTotalRange {
+		return []entity.TimeEntryTotal{}, errors.NewWithCode(codes.CodeBadRequest, "range must not be longer than 366 days")
+	}
+
+	if params.Location == nil {
+		params.Location = time.UTC
+	}
+
+	if params.TaskID.Valid {
+		// Make sure the task is accessible by the user
+		if _, err := te.task.Get(ctx, entity.TaskParam{ID: params.TaskID}); err != nil {
+			return []entity.TimeEntryTotal{}, err
+		}
+	}
+
+	params.UserID = user.User.ID
+
+	totals, err := te.timeEntry.GetTotal(ctx, params)
+	if err != nil {
+		return totals, err
+	}
+
+	if err := te.populateTotalNames(ctx, params.GroupBy, totals); err != nil {
+		return totals, err
+	}
+
+	return totals, nil
+}
+
+// populateTotalNames fill the task title or category name of the totals
+func (te *timeEntry) populateTotalNames(ctx context.Context, groupBy string, totals []entity.TimeEntryTotal) error {
+	ids := []int64{}
+	for _, total := range totals {
+		if id, err := strconv.ParseInt(total.Key, 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) < 1 {
+		return nil
+	}
+
+	names := map[string]string{}
+	switch groupBy {
+	case entity.TimeEntryGroupByTask:
+		// Deleted task is included, the time was spent on it anyway
+		tasks, _, err := te.taskDom.GetList(ctx, entity.TaskParam{IDs: ids, QueryOption: query.Option{DisableLimit: true}})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			names[strconv.FormatInt(task.ID, 10)] = task.Title
+		}
+	case entity.TimeEntryGroupByCategory:
+		categories, _, err := te.category.GetList(ctx, entity.CategoryParam{IDs: ids, QueryOption: query.Option{DisableLimit: true}})
+		if err != nil {
+			return err
+		}
+
+		for _, category := range categories {
+			names[strconv.FormatInt(category.ID, 10)] = category.Name
+		}
+	}
+
+	for i := range totals {
+		totals[i].Name = names[totals[i].Key]
+	}
+
+	return nil
+}
+
+func durationSeconds(startedAt, endedAt time.Time) int64 {
+	if endedAt.Before(startedAt) {
+		return 0
+	}
+
+	return int64(endedAt.Sub(startedAt) / time.Second)
+}
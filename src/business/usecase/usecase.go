@@ -2,34 +2,99 @@ package usecase
 
 import (
 	"github.com/adiatma85/gg-project/src/business/domain"
+	"github.com/adiatma85/gg-project/src/business/usecase/achievement"
+	"github.com/adiatma85/gg-project/src/business/usecase/attachment"
+	"github.com/adiatma85/gg-project/src/business/usecase/board"
+	"github.com/adiatma85/gg-project/src/business/usecase/calendar"
 	"github.com/adiatma85/gg-project/src/business/usecase/category"
+	"github.com/adiatma85/gg-project/src/business/usecase/comment"
+	"github.com/adiatma85/gg-project/src/business/usecase/focus"
+	"github.com/adiatma85/gg-project/src/business/usecase/habit"
+	"github.com/adiatma85/gg-project/src/business/usecase/leaderboard"
+	"github.com/adiatma85/gg-project/src/business/usecase/progress"
+	"github.com/adiatma85/gg-project/src/business/usecase/reminder"
 	"github.com/adiatma85/gg-project/src/business/usecase/role"
+	"github.com/adiatma85/gg-project/src/business/usecase/search"
+	"github.com/adiatma85/gg-project/src/business/usecase/tag"
 	"github.com/adiatma85/gg-project/src/business/usecase/task"
+	"github.com/adiatma85/gg-project/src/business/usecase/timeentry"
 	"github.com/adiatma85/gg-project/src/business/usecase/user"
+	"github.com/adiatma85/gg-project/src/business/usecase/workspace"
 	"github.com/adiatma85/own-go-sdk/jwtAuth"
 	"github.com/adiatma85/own-go-sdk/log"
 )
 
 type Usecase struct {
-	User     user.Interface
-	Category category.Interface
-	Task     task.Interface
-	Role     role.Interface
+	User        user.Interface
+	Category    category.Interface
+	Task        task.Interface
+	Role        role.Interface
+	Comment     comment.Interface
+	Attachment  attachment.Interface
+	Tag         tag.Interface
+	Search      search.Interface
+	Reminder    reminder.Interface
+	Calendar    calendar.Interface
+	TimeEntry   timeentry.Interface
+	Focus       focus.Interface
+	Progress    progress.Interface
+	Achievement achievement.Interface
+	Leaderboard leaderboard.Interface
+	Habit       habit.Interface
+	Workspace   workspace.Interface
+	Board       board.Interface
+}
+
+type Config struct {
+	Task        task.Config
+	Attachment  attachment.Config
+	Reminder    reminder.Config
+	Focus       focus.Config
+	Progress    progress.Config
+	Leaderboard leaderboard.Config
 }
 
 type InitParam struct {
 	Log     log.Interface
 	Dom     *domain.Domain
 	JwtAuth jwtAuth.Interface
+	Conf    Config
 }
 
 func Init(param InitParam) *Usecase {
+	// The tasks, the categories and the leaderboards are scoped by the workspaces of the user
+	workspaceUc := workspace.Init(workspace.InitParam{Log: param.Log, Workspace: param.Dom.Workspace, WorkspaceMember: param.Dom.WorkspaceMember, WorkspaceInvitation: param.Dom.WorkspaceInvitation, User: param.Dom.User, JwtAuth: param.JwtAuth})
+
+	// Completing a task and reading the profile update and show the progress, the leaderboards and the achievements of the user
+	leaderboardUc := leaderboard.Init(leaderboard.InitParam{Log: param.Log, Leaderboard: param.Dom.Leaderboard, User: param.Dom.User, UserProgress: param.Dom.UserProgress, Workspace: workspaceUc, JwtAuth: param.JwtAuth, Conf: param.Conf.Leaderboard})
+	progressUc := progress.Init(progress.InitParam{Log: param.Log, UserProgress: param.Dom.UserProgress, Leaderboard: leaderboardUc, JwtAuth: param.JwtAuth, Conf: param.Conf.Progress})
+	achievementUc := achievement.Init(achievement.InitParam{Log: param.Log, Achievement: param.Dom.Achievement, UserAchievement: param.Dom.UserAchievement, FocusSession: param.Dom.FocusSession, TaskDom: param.Dom.Task, Progress: progressUc, JwtAuth: param.JwtAuth})
+
+	taskParam := task.InitParam{Log: param.Log, Task: param.Dom.Task, TaskDependency: param.Dom.TaskDependency, TaskStatusHistory: param.Dom.TaskStatusHistory, Tag: param.Dom.Tag, TaskTag: param.Dom.TaskTag, TaskReminder: param.Dom.TaskReminder, Category: param.Dom.Category, User: param.Dom.User, Workspace: workspaceUc, Progress: progressUc, Achievement: achievementUc, JwtAuth: param.JwtAuth, Conf: param.Conf.Task}
+
 	usecase := &Usecase{
-		User:     user.Init(user.InitParam{Log: param.Log, User: param.Dom.User, JwtAuth: param.JwtAuth}),
-		Category: category.Init(category.InitParam{Log: param.Log, Category: param.Dom.Category, JwtAuth: param.JwtAuth}),
-		Task:     task.Init(task.InitParam{Log: param.Log, Task: param.Dom.Task, JwtAuth: param.JwtAuth}),
-		Role:     role.Init(role.InitParam{Log: param.Log, Role: param.Dom.Role, JwtAuth: param.JwtAuth}),
+		User:        user.Init(user.InitParam{Log: param.Log, User: param.Dom.User, Progress: progressUc, JwtAuth: param.JwtAuth}),
+		Category:    category.Init(category.InitParam{Log: param.Log, Category: param.Dom.Category, Workspace: workspaceUc, JwtAuth: param.JwtAuth}),
+		Task:        task.Init(taskParam),
+		Role:        role.Init(role.InitParam{Log: param.Log, Role: param.Dom.Role, JwtAuth: param.JwtAuth}),
+		Search:      search.Init(search.InitParam{Log: param.Log, Search: param.Dom.Search, Workspace: workspaceUc, JwtAuth: param.JwtAuth}),
+		Progress:    progressUc,
+		Achievement: achievementUc,
+		Leaderboard: leaderboardUc,
+		Workspace:   workspaceUc,
 	}
 
+	// The usecases below reuse the task usecase to respect the task ownership rules
+	usecase.Comment = comment.Init(comment.InitParam{Log: param.Log, Comment: param.Dom.Comment, Task: usecase.Task, JwtAuth: param.JwtAuth})
+	usecase.Attachment = attachment.Init(attachment.InitParam{Log: param.Log, Attachment: param.Dom.Attachment, Storage: param.Dom.Storage, Task: usecase.Task, JwtAuth: param.JwtAuth, Conf: param.Conf.Attachment})
+	usecase.Tag = tag.Init(tag.InitParam{Log: param.Log, Tag: param.Dom.Tag, TaskTag: param.Dom.TaskTag, Task: usecase.Task, JwtAuth: param.JwtAuth})
+	usecase.Reminder = reminder.Init(reminder.InitParam{Log: param.Log, TaskReminder: param.Dom.TaskReminder, TaskDom: param.Dom.Task, User: param.Dom.User, Notifier: param.Dom.Notifier, Task: usecase.Task, JwtAuth: param.JwtAuth, Conf: param.Conf.Reminder})
+	// The calendar feed is read without the JWT, so it gets the only task usecase which takes the user from the caller
+	usecase.Calendar = calendar.Init(calendar.InitParam{Log: param.Log, User: param.Dom.User, Task: task.InitCalendar(taskParam), JwtAuth: param.JwtAuth})
+	usecase.TimeEntry = timeentry.Init(timeentry.InitParam{Log: param.Log, TimeEntry: param.Dom.TimeEntry, TaskDom: param.Dom.Task, Category: param.Dom.Category, Task: usecase.Task, JwtAuth: param.JwtAuth})
+	usecase.Focus = focus.Init(focus.InitParam{Log: param.Log, FocusSession: param.Dom.FocusSession, Task: usecase.Task, Achievement: achievementUc, JwtAuth: param.JwtAuth, Conf: param.Conf.Focus})
+	usecase.Habit = habit.Init(habit.InitParam{Log: param.Log, HabitCheckIn: param.Dom.HabitCheckIn, Task: usecase.Task, JwtAuth: param.JwtAuth})
+	usecase.Board = board.Init(board.InitParam{Log: param.Log, Board: param.Dom.Board, BoardColumn: param.Dom.BoardColumn, BoardTask: param.Dom.BoardTask, Task: usecase.Task, Workspace: workspaceUc, JwtAuth: param.JwtAuth})
+
 	return usecase
 }
@@ -7,6 +7,7 @@ import (
 
 	userDom "github.com/adiatma85/gg-project/src/business/domain/user"
 	"github.com/adiatma85/gg-project/src/business/entity"
+	progressUc "github.com/adiatma85/gg-project/src/business/usecase/progress"
 	"github.com/adiatma85/own-go-sdk/codes"
 	"github.com/adiatma85/own-go-sdk/errors"
 	"github.com/adiatma85/own-go-sdk/jwtAuth"
@@ -37,24 +38,27 @@ type Interface interface {
 }
 
 type InitParam struct {
-	Log     log.Interface
-	User    userDom.Interface
-	JwtAuth jwtAuth.Interface
+	Log      log.Interface
+	User     userDom.Interface
+	Progress progressUc.Interface
+	JwtAuth  jwtAuth.Interface
 }
 
 type user struct {
-	log     log.Interface
-	user    userDom.Interface
-	jwtAuth jwtAuth.Interface
+	log      log.Interface
+	user     userDom.Interface
+	progress progressUc.Interface
+	jwtAuth  jwtAuth.Interface
 }
 
 var Now = time.Now
 
 func Init(param InitParam) Interface {
 	u := &user{
-		log:     param.Log,
-		user:    param.User,
-		jwtAuth: param.JwtAuth,
+		log:      param.Log,
+		user:     param.User,
+		progress: param.Progress,
+		jwtAuth:  param.JwtAuth,
 	}
 
 	return u
@@ -246,7 +250,20 @@ func (u *user) GetSelfProfile(ctx context.Context) (entity.User, error) {
 		ID: null.Int64From(user.User.ID),
 	}
 
-	return u.user.Get(ctx, userParam)
+	profile, err := u.user.Get(ctx, userParam)
+	if err != nil {
+		return profile, err
+	}
+
+	profile.CalendarFeedEnabled = profile.CalendarToken.Valid
+
+	progress, err := u.progress.Get(ctx, profile.ID)
+	if err != nil {
+		return profile, err
+	}
+	profile.Level = &progress.Level
+
+	return profile, nil
 }
 
 func (u *user) SelfDelete(ctx context.Context) error {
@@ -0,0 +1,761 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	userDom "github.com/adiatma85/gg-project/src/business/domain/user"
+	workspaceDom "github.com/adiatma85/gg-project/src/business/domain/workspace"
+	workspaceInvitationDom "github.com/adiatma85/gg-project/src/business/domain/workspaceinvitation"
+	workspaceMemberDom "github.com/adiatma85/gg-project/src/business/domain/workspacemember"
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/jwtAuth"
+	"github.com/adiatma85/own-go-sdk/log"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/adiatma85/own-go-sdk/query"
+)
+
+type Interface interface {
+	Create(ctx context.Context, req entity.CreateWorkspaceParam) (entity.Workspace, error)
+	Get(ctx context.Context, params entity.WorkspaceParam) (entity.Workspace, error)
+	GetList(ctx context.Context, params entity.WorkspaceParam) ([]entity.Workspace, *entity.Pagination, error)
+	Update(ctx context.Context, updateParam entity.UpdateWorkspaceParam, selectParam entity.WorkspaceParam) error
+	Delete(ctx context.Context, selectParam entity.WorkspaceParam) error
+	TransferOwnership(ctx context.Context, selectParam entity.WorkspaceParam, req entity.TransferWorkspaceParam) (entity.Workspace, error)
+	Leave(ctx context.Context, selectParam entity.WorkspaceParam) error
+	GetMembers(ctx context.Context, params entity.WorkspaceMemberParam) ([]entity.WorkspaceMember, *entity.Pagination, error)
+	UpdateMember(ctx context.Context, updateParam entity.UpdateWorkspaceMemberParam, selectParam entity.WorkspaceMemberParam) (entity.WorkspaceMember, error)
+	RemoveMember(ctx context.Context, selectParam entity.WorkspaceMemberParam) error
+	Invite(ctx context.Context, selectParam entity.WorkspaceParam, req entity.CreateWorkspaceInvitationParam) (entity.WorkspaceInvitation, error)
+	GetInvitations(ctx context.Context, params entity.WorkspaceInvitationParam) ([]entity.WorkspaceInvitation, *entity.Pagination, error)
+	RevokeInvitation(ctx context.Context, selectParam entity.WorkspaceInvitationParam) error
+	GetMyInvitations(ctx context.Context, params entity.WorkspaceInvitationParam) ([]entity.WorkspaceInvitation, *entity.Pagination, error)
+	AcceptInvitation(ctx context.Context, selectParam entity.WorkspaceInvitationParam) (entity.WorkspaceMember, error)
+	DeclineInvitation(ctx context.Context, selectParam entity.WorkspaceInvitationParam) error
+	Authorize(ctx context.Context, workspaceID, userID int64, roles ...string) (entity.WorkspaceMember, error)
+	GetWorkspaceIDs(ctx context.Context, userID int64) ([]int64, error)
+}
+
+type InitParam struct {
+	Log                 log.Interface
+	Workspace           workspaceDom.Interface
+	WorkspaceMember     workspaceMemberDom.Interface
+	WorkspaceInvitation workspaceInvitationDom.Interface
+	User                userDom.Interface
+	JwtAuth             jwtAuth.Interface
+}
+
+type workspace struct {
+	log                 log.Interface
+	workspace           workspaceDom.Interface
+	workspaceMember     workspaceMemberDom.Interface
+	workspaceInvitation workspaceInvitationDom.Interface
+	user                userDom.Interface
+	jwtAuth             jwtAuth.Interface
+}
+
+var Now = time.Now
+
+func Init(param InitParam) Interface {
+	w := &workspace{
+		log:                 param.Log,
+		workspace:           param.Workspace,
+		workspaceMember:     param.WorkspaceMember,
+		workspaceInvitation: param.WorkspaceInvitation,
+		user:                param.User,
+		jwtAuth:             param.JwtAuth,
+	}
+
+	return w
+}
+
+// Create the workspace, the user who creates it is its owner
+func (w *workspace) Create(ctx context.Context, req entity.CreateWorkspaceParam) (entity.Workspace, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Workspace{}, err
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return entity.Workspace{}, errors.NewWithCode(codes.CodeBadRequest, "name is required")
+	}
+
+	req.OwnerID = user.User.ID
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	workspace, err := w.workspace.Create(ctx, req)
+	if err != nil {
+		return workspace, err
+	}
+
+	workspace.Role = entity.WorkspaceRoleOwner
+
+	return workspace, nil
+}
+
+func (w *workspace) Get(ctx context.Context, params entity.WorkspaceParam) (entity.Workspace, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Workspace{}, err
+	}
+
+	member, err := w.Authorize(ctx, params.ID.Int64, user.User.ID)
+	if err != nil {
+		return entity.Workspace{}, err
+	}
+
+	workspace, err := w.workspace.Get(ctx, entity.WorkspaceParam{
+		ID: params.ID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err != nil {
+		return workspace, err
+	}
+
+	workspace.Role = member.Role
+
+	return workspace, nil
+}
+
+// GetList return the workspaces the user is a member of
+func (w *workspace) GetList(ctx context.Context, params entity.WorkspaceParam) ([]entity.Workspace, *entity.Pagination, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members, _, err := w.workspaceMember.GetList(ctx, entity.WorkspaceMemberParam{
+		UserID: null.Int64From(user.User.ID),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(members) == 0 {
+		pg := entity.Pagination{CurrentPage: params.Page}
+		pg.ProcessPagination(params.Limit)
+		return []entity.Workspace{}, &pg, nil
+	}
+
+	roles := map[int64]string{}
+	for _, member := range members {
+		params.IDs = append(params.IDs, member.WorkspaceID)
+		roles[member.WorkspaceID] = member.Role
+	}
+
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	workspaces, pg, err := w.workspace.GetList(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range workspaces {
+		workspaces[i].Role = roles[workspaces[i].ID]
+	}
+
+	return workspaces, pg, nil
+}
+
+func (w *workspace) Update(ctx context.Context, updateParam entity.UpdateWorkspaceParam, selectParam entity.WorkspaceParam) error {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Authorize(ctx, selectParam.ID.Int64, user.User.ID, entity.WorkspaceRoleOwner, entity.WorkspaceRoleAdmin); err != nil {
+		return err
+	}
+
+	// The owner is changed by transferring the ownership
+	updateParam.OwnerID = null.Int64{}
+	updateParam.Name = strings.TrimSpace(updateParam.Name)
+	updateParam.UpdatedAt = null.TimeFrom(Now())
+	updateParam.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return w.workspace.Update(ctx, updateParam, entity.WorkspaceParam{ID: selectParam.ID})
+}
+
+// Delete the workspace with its memberships, the tasks stay with their owners and assignees
+func (w *workspace) Delete(ctx context.Context, selectParam entity.WorkspaceParam) error {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Authorize(ctx, selectParam.ID.Int64, user.User.ID, entity.WorkspaceRoleOwner); err != nil {
+		return err
+	}
+
+	deletedAt := null.TimeFrom(Now())
+	deletedBy := null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	if err := w.workspaceMember.Update(ctx, entity.UpdateWorkspaceMemberParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: deletedAt,
+		DeletedBy: deletedBy,
+	}, entity.WorkspaceMemberParam{
+		WorkspaceID: selectParam.ID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}); err != nil {
+		return err
+	}
+
+	return w.workspace.Update(ctx, entity.UpdateWorkspaceParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: deletedAt,
+		DeletedBy: deletedBy,
+	}, entity.WorkspaceParam{ID: selectParam.ID})
+}
+
+// TransferOwnership make another member the owner, the old owner stays in the workspace as an admin
+func (w *workspace) TransferOwnership(ctx context.Context, selectParam entity.WorkspaceParam, req entity.TransferWorkspaceParam) (entity.Workspace, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.Workspace{}, err
+	}
+
+	if _, err := w.Authorize(ctx, selectParam.ID.Int64, user.User.ID, entity.WorkspaceRoleOwner); err != nil {
+		return entity.Workspace{}, err
+	}
+
+	workspace, err := w.workspace.Get(ctx, entity.WorkspaceParam{
+		ID: selectParam.ID,
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err != nil {
+		return workspace, err
+	}
+
+	if req.UserID == workspace.OwnerID {
+		return workspace, errors.NewWithCode(codes.CodeBadRequest, "user is already the owner of the workspace")
+	}
+
+	if _, err := w.getMember(ctx, workspace.ID, req.UserID); errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return workspace, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("user %d is not a member of the workspace", req.UserID))
+	} else if err != nil {
+		return workspace, err
+	}
+
+	if err := w.workspace.TransferOwnership(ctx, workspace, req.UserID, null.StringFrom(fmt.Sprintf("%v", user.User.ID))); err != nil {
+		return workspace, err
+	}
+
+	workspace.OwnerID = req.UserID
+	workspace.Role = entity.WorkspaceRoleAdmin
+	if user.User.ID == req.UserID {
+		workspace.Role = entity.WorkspaceRoleOwner
+	}
+
+	return workspace, nil
+}
+
+// Leave the workspace, the owner has to transfer the ownership first
+func (w *workspace) Leave(ctx context.Context, selectParam entity.WorkspaceParam) error {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	member, err := w.getMember(ctx, selectParam.ID.Int64, user.User.ID)
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return errors.NewWithCode(codes.CodeNotFound, "user is not a member of the workspace")
+	} else if err != nil {
+		return err
+	}
+
+	if member.Role == entity.WorkspaceRoleOwner {
+		return errors.NewWithCode(codes.CodeBadRequest, "owner can not leave the workspace, transfer the ownership first")
+	}
+
+	return w.deleteMember(ctx, member, user.User.ID)
+}
+
+func (w *workspace) GetMembers(ctx context.Context, params entity.WorkspaceMemberParam) ([]entity.WorkspaceMember, *entity.Pagination, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := w.Authorize(ctx, params.WorkspaceID.Int64, user.User.ID); err != nil {
+		return nil, nil, err
+	}
+
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	members, pg, err := w.workspaceMember.GetList(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := w.populateUsers(ctx, members); err != nil {
+		return nil, nil, err
+	}
+
+	return members, pg, nil
+}
+
+// UpdateMember change the role of the member, only the owner can change the role of an admin
+func (w *workspace) UpdateMember(ctx context.Context, updateParam entity.UpdateWorkspaceMemberParam, selectParam entity.WorkspaceMemberParam) (entity.WorkspaceMember, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.WorkspaceMember{}, err
+	}
+
+	if updateParam.Role != entity.WorkspaceRoleAdmin && updateParam.Role != entity.WorkspaceRoleMember {
+		return entity.WorkspaceMember{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid role %q, must be one of admin, member", updateParam.Role))
+	}
+
+	member, err := w.getManagedMember(ctx, selectParam, user.User.ID)
+	if err != nil {
+		return member, err
+	}
+
+	if err := w.workspaceMember.Update(ctx, entity.UpdateWorkspaceMemberParam{
+		Role:      updateParam.Role,
+		UpdatedAt: null.TimeFrom(Now()),
+		UpdatedBy: null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	}, entity.WorkspaceMemberParam{ID: null.Int64From(member.ID)}); err != nil {
+		return member, err
+	}
+
+	member.Role = updateParam.Role
+
+	members := []entity.WorkspaceMember{member}
+	if err := w.populateUsers(ctx, members); err != nil {
+		return member, err
+	}
+
+	return members[0], nil
+}
+
+// RemoveMember remove the user from the workspace, only the owner can remove an admin
+func (w *workspace) RemoveMember(ctx context.Context, selectParam entity.WorkspaceMemberParam) error {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	member, err := w.getManagedMember(ctx, selectParam, user.User.ID)
+	if err != nil {
+		return err
+	}
+
+	return w.deleteMember(ctx, member, user.User.ID)
+}
+
+// Invite the email to the workspace, the user can accept the invitation once they are registered with the email
+func (w *workspace) Invite(ctx context.Context, selectParam entity.WorkspaceParam, req entity.CreateWorkspaceInvitationParam) (entity.WorkspaceInvitation, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.WorkspaceInvitation{}, err
+	}
+
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	if req.Email == "" {
+		return entity.WorkspaceInvitation{}, errors.NewWithCode(codes.CodeBadRequest, "email is required")
+	}
+
+	if req.Role == "" {
+		req.Role = entity.WorkspaceRoleMember
+	} else if req.Role != entity.WorkspaceRoleAdmin && req.Role != entity.WorkspaceRoleMember {
+		return entity.WorkspaceInvitation{}, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid role %q, must be one of admin, member", req.Role))
+	}
+
+	if _, err := w.Authorize(ctx, selectParam.ID.Int64, user.User.ID, entity.WorkspaceRoleOwner, entity.WorkspaceRoleAdmin); err != nil {
+		return entity.WorkspaceInvitation{}, err
+	}
+
+	invitee, err := w.user.Get(ctx, entity.UserParam{
+		Email: null.StringFrom(req.Email),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err != nil && errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return entity.WorkspaceInvitation{}, err
+	} else if err == nil {
+		_, err := w.getMember(ctx, selectParam.ID.Int64, invitee.ID)
+		if err == nil {
+			return entity.WorkspaceInvitation{}, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("%s is already a member of the workspace", req.Email))
+		} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+			return entity.WorkspaceInvitation{}, err
+		}
+	}
+
+	_, err = w.workspaceInvitation.Get(ctx, entity.WorkspaceInvitationParam{
+		WorkspaceID:      selectParam.ID,
+		Email:            null.StringFrom(req.Email),
+		InvitationStatus: null.StringFrom(entity.WorkspaceInvitationStatusPending),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+	if err == nil {
+		return entity.WorkspaceInvitation{}, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("%s is already invited to the workspace", req.Email))
+	} else if errors.GetCode(err) != codes.CodeSQLRecordDoesNotExist {
+		return entity.WorkspaceInvitation{}, err
+	}
+
+	req.WorkspaceID = selectParam.ID.Int64
+	req.InviterID = user.User.ID
+	req.CreatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+	req.UpdatedBy = null.StringFrom(fmt.Sprintf("%v", user.User.ID))
+
+	return w.workspaceInvitation.Create(ctx, req)
+}
+
+// GetInvitations return the invitations sent by the workspace
+func (w *workspace) GetInvitations(ctx context.Context, params entity.WorkspaceInvitationParam) ([]entity.WorkspaceInvitation, *entity.Pagination, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := w.Authorize(ctx, params.WorkspaceID.Int64, user.User.ID, entity.WorkspaceRoleOwner, entity.WorkspaceRoleAdmin); err != nil {
+		return nil, nil, err
+	}
+
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	invitations, pg, err := w.workspaceInvitation.GetList(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := w.populateWorkspaceNames(ctx, invitations); err != nil {
+		return nil, nil, err
+	}
+
+	return invitations, pg, nil
+}
+
+func (w *workspace) RevokeInvitation(ctx context.Context, selectParam entity.WorkspaceInvitationParam) error {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Authorize(ctx, selectParam.WorkspaceID.Int64, user.User.ID, entity.WorkspaceRoleOwner, entity.WorkspaceRoleAdmin); err != nil {
+		return err
+	}
+
+	invitation, err := w.getPendingInvitation(ctx, entity.WorkspaceInvitationParam{
+		ID:          selectParam.ID,
+		WorkspaceID: selectParam.WorkspaceID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.respondInvitation(ctx, invitation, entity.WorkspaceInvitationStatusRevoked, user.User.ID)
+}
+
+// GetMyInvitations return the pending invitations sent to the email of the user
+func (w *workspace) GetMyInvitations(ctx context.Context, params entity.WorkspaceInvitationParam) ([]entity.WorkspaceInvitation, *entity.Pagination, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params.Email = null.StringFrom(strings.ToLower(user.User.Email))
+	params.InvitationStatus = null.StringFrom(entity.WorkspaceInvitationStatusPending)
+	params.IncludePagination = true
+	params.QueryOption.IsActive = true
+
+	invitations, pg, err := w.workspaceInvitation.GetList(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := w.populateWorkspaceNames(ctx, invitations); err != nil {
+		return nil, nil, err
+	}
+
+	return invitations, pg, nil
+}
+
+// AcceptInvitation join the workspace with the role of the invitation
+func (w *workspace) AcceptInvitation(ctx context.Context, selectParam entity.WorkspaceInvitationParam) (entity.WorkspaceMember, error) {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return entity.WorkspaceMember{}, err
+	}
+
+	invitation, err := w.getPendingInvitation(ctx, entity.WorkspaceInvitationParam{
+		ID:    selectParam.ID,
+		Email: null.StringFrom(strings.ToLower(user.User.Email)),
+	})
+	if err != nil {
+		return entity.WorkspaceMember{}, err
+	}
+
+	if _, err := w.workspace.Get(ctx, entity.WorkspaceParam{
+		ID: null.Int64From(invitation.WorkspaceID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	}); errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return entity.WorkspaceMember{}, errors.NewWithCode(codes.CodeNotFound, "workspace of the invitation does not exist anymore")
+	} else if err != nil {
+		return entity.WorkspaceMember{}, err
+	}
+
+	// Joining again restore the membership, so the invitation is marked as accepted last
+	member, err := w.workspaceMember.Create(ctx, entity.CreateWorkspaceMemberParam{
+		WorkspaceID: invitation.WorkspaceID,
+		UserID:      user.User.ID,
+		Role:        invitation.Role,
+		CreatedBy:   null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+		UpdatedBy:   null.StringFrom(fmt.Sprintf("%v", user.User.ID)),
+	})
+	if err != nil {
+		return member, err
+	}
+
+	if err := w.respondInvitation(ctx, invitation, entity.WorkspaceInvitationStatusAccepted, user.User.ID); err != nil {
+		return member, err
+	}
+
+	members := []entity.WorkspaceMember{member}
+	if err := w.populateUsers(ctx, members); err != nil {
+		return member, err
+	}
+
+	return members[0], nil
+}
+
+func (w *workspace) DeclineInvitation(ctx context.Context, selectParam entity.WorkspaceInvitationParam) error {
+	user, err := w.jwtAuth.GetUserAuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := w.getPendingInvitation(ctx, entity.WorkspaceInvitationParam{
+		ID:    selectParam.ID,
+		Email: null.StringFrom(strings.ToLower(user.User.Email)),
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.respondInvitation(ctx, invitation, entity.WorkspaceInvitationStatusDeclined, user.User.ID)
+}
+
+// Authorize return the membership of the user, it fails when the user is not a member or has none of the roles.
+// Every role is allowed when no role is given, the admin of the installation is treated as the owner
+func (w *workspace) Authorize(ctx context.Context, workspaceID, userID int64, roles ...string) (entity.WorkspaceMember, error) {
+	if entity.IsSuperAdmin(ctx, userID) {
+		if _, err := w.workspace.Get(ctx, entity.WorkspaceParam{
+			ID: null.Int64From(workspaceID),
+			QueryOption: query.Option{
+				IsActive: true,
+			},
+		}); errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+			return entity.WorkspaceMember{}, errors.NewWithCode(codes.CodeNotFound, fmt.Sprintf("workspace %d does not exist", workspaceID))
+		} else if err != nil {
+			return entity.WorkspaceMember{}, err
+		}
+
+		return entity.WorkspaceMember{WorkspaceID: workspaceID, UserID: userID, Role: entity.WorkspaceRoleOwner}, nil
+	}
+
+	member, err := w.getMember(ctx, workspaceID, userID)
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return member, errors.NewWithCode(codes.CodeForbidden, fmt.Sprintf("user is not a member of workspace %d", workspaceID))
+	} else if err != nil {
+		return member, err
+	}
+
+	if len(roles) == 0 {
+		return member, nil
+	}
+
+	for _, role := range roles {
+		if member.Role == role {
+			return member, nil
+		}
+	}
+
+	return member, errors.NewWithCode(codes.CodeForbidden, fmt.Sprintf("%s of the workspace is not allowed to do this", member.Role))
+}
+
+// GetWorkspaceIDs return the workspaces the user is a member of
+func (w *workspace) GetWorkspaceIDs(ctx context.Context, userID int64) ([]int64, error) {
+	members, _, err := w.workspaceMember.GetList(ctx, entity.WorkspaceMemberParam{
+		UserID: null.Int64From(userID),
+		QueryOption: query.Option{
+			IsActive:     true,
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, member := range members {
+		ids = append(ids, member.WorkspaceID)
+	}
+
+	return ids, nil
+}
+
+func (w *workspace) getMember(ctx context.Context, workspaceID, userID int64) (entity.WorkspaceMember, error) {
+	return w.workspaceMember.Get(ctx, entity.WorkspaceMemberParam{
+		WorkspaceID: null.Int64From(workspaceID),
+		UserID:      null.Int64From(userID),
+		QueryOption: query.Option{
+			IsActive: true,
+		},
+	})
+}
+
+// getManagedMember return the member which can be changed by the user, the owner can not be changed and only the
+// owner can change an admin
+func (w *workspace) getManagedMember(ctx context.Context, selectParam entity.WorkspaceMemberParam, userID int64) (entity.WorkspaceMember, error) {
+	manager, err := w.Authorize(ctx, selectParam.WorkspaceID.Int64, userID, entity.WorkspaceRoleOwner, entity.WorkspaceRoleAdmin)
+	if err != nil {
+		return entity.WorkspaceMember{}, err
+	}
+
+	member, err := w.getMember(ctx, selectParam.WorkspaceID.Int64, selectParam.UserID.Int64)
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return member, errors.NewWithCode(codes.CodeNotFound, fmt.Sprintf("user %d is not a member of the workspace", selectParam.UserID.Int64))
+	} else if err != nil {
+		return member, err
+	}
+
+	if member.Role == entity.WorkspaceRoleOwner {
+		return member, errors.NewWithCode(codes.CodeForbidden, "owner of the workspace can not be changed, transfer the ownership instead")
+	}
+
+	if member.Role == entity.WorkspaceRoleAdmin && manager.Role != entity.WorkspaceRoleOwner {
+		return member, errors.NewWithCode(codes.CodeForbidden, "only the owner can change an admin of the workspace")
+	}
+
+	return member, nil
+}
+
+func (w *workspace) deleteMember(ctx context.Context, member entity.WorkspaceMember, userID int64) error {
+	return w.workspaceMember.Update(ctx, entity.UpdateWorkspaceMemberParam{
+		Status:    null.Int64From(-1),
+		DeletedAt: null.TimeFrom(Now()),
+		DeletedBy: null.StringFrom(fmt.Sprintf("%v", userID)),
+	}, entity.WorkspaceMemberParam{ID: null.Int64From(member.ID)})
+}
+
+func (w *workspace) getPendingInvitation(ctx context.Context, params entity.WorkspaceInvitationParam) (entity.WorkspaceInvitation, error) {
+	params.QueryOption.IsActive = true
+
+	invitation, err := w.workspaceInvitation.Get(ctx, params)
+	if errors.GetCode(err) == codes.CodeSQLRecordDoesNotExist {
+		return invitation, errors.NewWithCode(codes.CodeNotFound, "invitation does not exist")
+	} else if err != nil {
+		return invitation, err
+	}
+
+	if invitation.InvitationStatus != entity.WorkspaceInvitationStatusPending {
+		return invitation, errors.NewWithCode(codes.CodeConflict, fmt.Sprintf("invitation is already %s", invitation.InvitationStatus))
+	}
+
+	return invitation, nil
+}
+
+func (w *workspace) respondInvitation(ctx context.Context, invitation entity.WorkspaceInvitation, invitationStatus string, userID int64) error {
+	now := null.TimeFrom(Now())
+
+	return w.workspaceInvitation.Update(ctx, entity.UpdateWorkspaceInvitationParam{
+		InvitationStatus: invitationStatus,
+		RespondedAt:      now,
+		UpdatedAt:        now,
+		UpdatedBy:        null.StringFrom(fmt.Sprintf("%v", userID)),
+	}, entity.WorkspaceInvitationParam{ID: null.Int64From(invitation.ID)})
+}
+
+// populateUsers fill the names of the members
+func (w *workspace) populateUsers(ctx context.Context, members []entity.WorkspaceMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	userIDs := []int64{}
+	for _, member := range members {
+		userIDs = append(userIDs, member.UserID)
+	}
+
+	users, _, err := w.user.GetList(ctx, entity.UserParam{
+		IDs: userIDs,
+		QueryOption: query.Option{
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	userMap := map[int64]entity.User{}
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	for i := range members {
+		user := userMap[members[i].UserID]
+		members[i].Username = user.Username
+		members[i].DisplayName = user.DisplayName
+	}
+
+	return nil
+}
+
+// populateWorkspaceNames fill the names of the workspaces of the invitations
+func (w *workspace) populateWorkspaceNames(ctx context.Context, invitations []entity.WorkspaceInvitation) error {
+	if len(invitations) == 0 {
+		return nil
+	}
+
+	workspaceIDs := []int64{}
+	for _, invitation := range invitations {
+		workspaceIDs = append(workspaceIDs, invitation.WorkspaceID)
+	}
+
+	workspaces, _, err := w.workspace.GetList(ctx, entity.WorkspaceParam{
+		IDs: workspaceIDs,
+		QueryOption: query.Option{
+			DisableLimit: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	names := map[int64]string{}
+	for _, workspace := range workspaces {
+		names[workspace.ID] = workspace.Name
+	}
+
+	for i := range invitations {
+		invitations[i].WorkspaceName = names[invitations[i].WorkspaceID]
+	}
+
+	return nil
+}
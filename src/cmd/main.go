@@ -4,7 +4,9 @@ import (
 	"github.com/adiatma85/gg-project/src/business/domain"
 	"github.com/adiatma85/gg-project/src/business/usecase"
 	"github.com/adiatma85/gg-project/src/handler"
+	"github.com/adiatma85/gg-project/src/scheduler"
 	"github.com/adiatma85/gg-project/utils/config"
+	"github.com/adiatma85/gg-project/utils/redis"
 	"github.com/adiatma85/own-go-sdk/configreader"
 	"github.com/adiatma85/own-go-sdk/instrument"
 	"github.com/adiatma85/own-go-sdk/jwtAuth"
@@ -44,6 +46,9 @@ func main() {
 	// Init the DB
 	db := sql.Init(cfg.SQL, log, instr)
 
+	// Init the redis, the client is shared by the domains
+	rdb := redis.Init(cfg.Redis, log)
+
 	// init the parser
 	parsers := parser.InitParser(log, cfg.Parser)
 
@@ -51,13 +56,17 @@ func main() {
 	jwt := jwtAuth.Init(cfg.JwtAuth)
 
 	// Init the domain
-	d := domain.Init(domain.InitParam{Log: log, Db: db, Json: parsers.JSONParser()})
+	d := domain.Init(domain.InitParam{Log: log, Db: db, Json: parsers.JSONParser(), Storage: cfg.Storage, Notifier: cfg.Notifier, Redis: rdb})
 
 	// Init the usecase
-	uc := usecase.Init(usecase.InitParam{Log: log, Dom: d, JwtAuth: jwt})
+	uc := usecase.Init(usecase.InitParam{Log: log, Dom: d, JwtAuth: jwt, Conf: cfg.Usecase})
 
 	// Init the GIN
 	rest := handler.Init(handler.InitParam{Conf: cfg.Gin, Json: parsers.JSONParser(), Log: log, Uc: uc, Instrument: instr, JwtAuth: jwt})
 
+	// Init the scheduler, the background jobs run next to the HTTP server
+	sch := scheduler.Init(scheduler.InitParam{Conf: cfg.Scheduler, Log: log, Uc: uc})
+	go sch.Run()
+
 	rest.Run()
 }
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get Achievement List
+// @Description Get every achievement with whether the user has unlocked it, the achievements are unlocked by finishing tasks and focus sessions
+// @Security BearerAuth
+// @Tags Achievement
+// @Param unlocked query boolean false "only the unlocked (true) or locked (false) achievements" Enums(true, false)
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Achievement{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/achievements [GET]
+func (r *rest) GetListAchievement(ctx *gin.Context) {
+	var param entity.AchievementParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	achievements, err := r.uc.Achievement.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, achievements, nil)
+}
+
+// @Summary Create Achievement
+// @Description Define new achievement, it is unlocked once the metric of its rule reaches the threshold
+// @Security BearerAuth
+// @Tags Achievement
+// @Param data body entity.CreateAchievementParam true "Input New Achievement Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Achievement{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 401 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/achievements [POST]
+func (r *rest) CreateAchievement(ctx *gin.Context) {
+	var param entity.CreateAchievementParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	achievement, err := r.uc.Achievement.Create(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, achievement, nil)
+}
+
+// @Summary Update Achievement
+// @Description Update the achievement, the code can not be changed
+// @Security BearerAuth
+// @Tags Achievement
+// @Param achievement_id path integer true "achievement id"
+// @Param data body entity.UpdateAchievementParam true "Achievement Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 401 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/achievements/{achievement_id} [PUT]
+func (r *rest) UpdateAchievement(ctx *gin.Context) {
+	var updateParam entity.UpdateAchievementParam
+	if err := r.Bind(ctx, &updateParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.AchievementParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Achievement.Update(ctx.Request.Context(), updateParam, selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Delete Achievement
+// @Description Soft delete the achievement, it is no longer listed nor unlocked
+// @Security BearerAuth
+// @Tags Achievement
+// @Param achievement_id path integer true "achievement id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 401 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/admin/achievements/{achievement_id} [DELETE]
+func (r *rest) DeleteAchievement(ctx *gin.Context) {
+	var selectParam entity.AchievementParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Achievement.Delete(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Upload Task Attachment
+// @Description Upload a file and attach it to the Task
+// @Security BearerAuth
+// @Tags Attachment
+// @Accept multipart/form-data
+// @Param task_id path integer true "task id"
+// @Param file formData file true "File to upload"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TaskAttachment{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/attachments [POST]
+func (r *rest) CreateTaskAttachment(ctx *gin.Context) {
+	var taskParam entity.TaskParam
+	if err := r.BindUri(ctx, &taskParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, err.Error()))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, err.Error()))
+		return
+	}
+	defer file.Close()
+
+	param := entity.CreateTaskAttachmentParam{
+		FileName: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Content:  file,
+	}
+
+	attachment, err := r.uc.Attachment.Create(ctx.Request.Context(), taskParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, attachment, nil)
+}
+
+// @Summary Get Task Attachment List
+// @Description Get list of attachments on the Task
+// @Security BearerAuth
+// @Tags Attachment
+// @Param task_id path integer true "task id"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.TaskAttachment{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/attachments [GET]
+func (r *rest) GetListTaskAttachment(ctx *gin.Context) {
+	var param entity.TaskAttachmentParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	attachments, pg, err := r.uc.Attachment.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, attachments, pg)
+}
+
+// @Summary Download Task Attachment
+// @Description Download the attachment file, partial content is supported through the Range header
+// @Security BearerAuth
+// @Tags Attachment
+// @Param task_id path integer true "task id"
+// @Param attachment_id path integer true "attachment id"
+// @Produce octet-stream
+// @Success 200 {file} file
+// @Success 206 {file} file
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/attachments/{attachment_id}/download [GET]
+func (r *rest) DownloadTaskAttachment(ctx *gin.Context) {
+	var param entity.TaskAttachmentParam
+	if err := r.BindUri(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	file, err := r.uc.Attachment.Open(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+	defer file.Content.Close()
+
+	ctx.Header("Content-Type", file.MimeType)
+	ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}))
+	ctx.Header("ETag", fmt.Sprintf("%q", file.Checksum))
+
+	// ServeContent handle the Range and conditional request headers
+	http.ServeContent(ctx.Writer, ctx.Request, file.FileName, file.CreatedAt.Time, file.Content)
+}
+
+// @Summary Delete Task Attachment
+// @Description Delete the attachment from the Task, only the uploader or the owner of the Task can delete it
+// @Security BearerAuth
+// @Tags Attachment
+// @Param task_id path integer true "task id"
+// @Param attachment_id path integer true "attachment id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/attachments/{attachment_id} [DELETE]
+func (r *rest) DeleteTaskAttachment(ctx *gin.Context) {
+	var selectParam entity.TaskAttachmentParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Attachment.Delete(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
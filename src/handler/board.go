@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create Board
+// @Description Create new Board of the personal tasks or of the tasks of the workspace, a status column is created for every task status when no column is given
+// @Security BearerAuth
+// @Tags Board
+// @Param data body entity.CreateBoardParam true "Input New Board Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Board{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board [POST]
+func (r *rest) CreateBoard(ctx *gin.Context) {
+	var param entity.CreateBoardParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	board, err := r.uc.Board.Create(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, board, nil)
+}
+
+// @Summary Get Board List
+// @Description Get the personal Boards of the user and the Boards of the workspaces of the user, without their columns
+// @Security BearerAuth
+// @Tags Board
+// @Param workspaceId query integer false "Filter board of the workspace"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Board{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board [GET]
+func (r *rest) GetListBoard(ctx *gin.Context) {
+	var param entity.BoardParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	boards, pg, err := r.uc.Board.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, boards, pg)
+}
+
+// @Summary Get Board By ID
+// @Description Get the Board with its columns from left to right, every column has its tasks in their position on the board
+// @Security BearerAuth
+// @Tags Board
+// @Param board_id path integer true "board id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Board{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board/{board_id} [GET]
+func (r *rest) GetBoardByID(ctx *gin.Context) {
+	var param entity.BoardParam
+	if err := r.BindUri(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	board, err := r.uc.Board.Get(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, board, nil)
+}
+
+// @Summary Update Board
+// @Description Update the name and the description of the Board, only the owner of the board and the owner and the admins of its workspace can update it
+// @Security BearerAuth
+// @Tags Board
+// @Param board_id path integer true "board id"
+// @Param data body entity.UpdateBoardParam true "board data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board/{board_id} [PUT]
+func (r *rest) UpdateBoard(ctx *gin.Context) {
+	var updateParam entity.UpdateBoardParam
+	if err := r.Bind(ctx, &updateParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.BoardParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Board.Update(ctx.Request.Context(), updateParam, selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Delete Board
+// @Description Soft delete the Board with its columns, the tasks are left as is
+// @Security BearerAuth
+// @Tags Board
+// @Param board_id path integer true "board id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board/{board_id} [DELETE]
+func (r *rest) DeleteBoard(ctx *gin.Context) {
+	var selectParam entity.BoardParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Board.Delete(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Create Board Column
+// @Description Add a status column or a custom lane to the Board, a task status can only have one column on the board
+// @Security BearerAuth
+// @Tags Board
+// @Param board_id path integer true "board id"
+// @Param data body entity.CreateBoardColumnParam true "Input New Column Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.BoardColumn{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board/{board_id}/columns [POST]
+func (r *rest) CreateBoardColumn(ctx *gin.Context) {
+	var param entity.CreateBoardColumnParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.BoardParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	column, err := r.uc.Board.CreateColumn(ctx.Request.Context(), selectParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, column, nil)
+}
+
+// @Summary Update Board Column
+// @Description Rename or reorder the column, the type and the status of the column can not be changed
+// @Security BearerAuth
+// @Tags Board
+// @Param board_id path integer true "board id"
+// @Param column_id path integer true "column id"
+// @Param data body entity.UpdateBoardColumnParam true "column data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.BoardColumn{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board/{board_id}/columns/{column_id} [PUT]
+func (r *rest) UpdateBoardColumn(ctx *gin.Context) {
+	var updateParam entity.UpdateBoardColumnParam
+	if err := r.Bind(ctx, &updateParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.BoardColumnParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	column, err := r.uc.Board.UpdateColumn(ctx.Request.Context(), updateParam, selectParam)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, column, nil)
+}
+
+// @Summary Delete Board Column
+// @Description Remove the column from the Board, its tasks go back to the column of their status, the last column can not be deleted
+// @Security BearerAuth
+// @Tags Board
+// @Param board_id path integer true "board id"
+// @Param column_id path integer true "column id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board/{board_id}/columns/{column_id} [DELETE]
+func (r *rest) DeleteBoardColumn(ctx *gin.Context) {
+	var selectParam entity.BoardColumnParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Board.DeleteColumn(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Move Board Task
+// @Description Move the task to the column at the position, moving it to a status column changes the status of the task
+// @Security BearerAuth
+// @Tags Board
+// @Param board_id path integer true "board id"
+// @Param task_id path integer true "task id"
+// @Param data body entity.MoveBoardTaskParam true "Column and zero based position of the task"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Board{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/board/{board_id}/tasks/{task_id}/move [PUT]
+func (r *rest) MoveBoardTask(ctx *gin.Context) {
+	var param entity.MoveBoardTaskParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.BoardTaskParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	board, err := r.uc.Board.MoveTask(ctx.Request.Context(), selectParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, board, nil)
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/ics"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	icsProdID = "-//gg-project//Tasks//EN"
+	icsDomain = "gg-project"
+)
+
+// taskToICS convert the task due at the given time into calendar component
+func taskToICS(task entity.Task, dueTime time.Time, occurrence bool, kind string) ics.Component {
+	uid := ics.UID("task", task.ID, icsDomain)
+	status := ics.StatusNeedsAction
+	if occurrence {
+		// Each future occurrence needs its own UID so the calendar apps do not merge them
+		uid = ics.UID("task", fmt.Sprintf("%d-%s", task.ID, ics.FormatTime(dueTime)), icsDomain)
+	} else {
+		switch task.TaskStatus {
+		case entity.TaskStatusOnGoing:
+			status = ics.StatusInProcess
+		case entity.TaskStatusDone:
+			status = ics.StatusCompleted
+		}
+	}
+
+	stamp := task.UpdatedAt.Time
+	if !task.UpdatedAt.Valid {
+		stamp = task.CreatedAt.Time
+	}
+
+	categories := []string{}
+	for _, tag := range task.Tags {
+		categories = append(categories, tag.Name)
+	}
+
+	return ics.Component{
+		Kind:        kind,
+		UID:         uid,
+		Stamp:       stamp,
+		Summary:     task.Title,
+		Description: task.Description,
+		Categories:  categories,
+		Due:         dueTime,
+		Status:      status,
+	}
+}
+
+// @Summary Get Calendar Feed
+// @Description Get the iCalendar feed of the owner of the token, recurring tasks are expanded into their future occurrences
+// @Tags Calendar
+// @Param token path string true "calendar token, the .ics suffix is optional"
+// @Param type query string false "render the tasks as todo (VTODO) or event (VEVENT), default is todo" Enums(todo, event)
+// @Produce text/calendar
+// @Success 200 {string} string
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /public/v1/calendar/{token} [GET]
+func (r *rest) GetCalendarFeed(ctx *gin.Context) {
+	var param entity.CalendarFeedParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	kind := ics.ComponentTodo
+	switch param.Type {
+	case "", entity.CalendarFeedTypeTodo:
+	case entity.CalendarFeedTypeEvent:
+		kind = ics.ComponentEvent
+	default:
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid type %q", param.Type)))
+		return
+	}
+
+	calendar, err := r.uc.Calendar.GetFeed(ctx.Request.Context(), strings.TrimSuffix(param.Token, ".ics"))
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Type", ics.ContentType)
+	ctx.Header("Content-Disposition", `inline; filename="tasks.ics"`)
+	ctx.Header("Cache-Control", "private, max-age=300")
+	ctx.Status(http.StatusOK)
+
+	w := ics.NewWriter(ctx.Writer, icsProdID, calendar.Name)
+	for _, event := range calendar.Events {
+		if err := w.Write(taskToICS(event.Task, event.DueTime, event.Occurrence, kind)); err != nil {
+			r.log.Error(ctx.Request.Context(), err)
+			return
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		r.log.Error(ctx.Request.Context(), err)
+	}
+}
+
+// @Summary Generate Calendar Token
+// @Description Generate a new secret token of the calendar feed, the previous token stops working. The token is only shown once
+// @Security BearerAuth
+// @Tags Calendar
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.CalendarTokenResponse{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/user/profile/calendar-token [POST]
+func (r *rest) GenerateCalendarToken(ctx *gin.Context) {
+	token, err := r.uc.Calendar.GenerateToken(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, token, nil)
+}
+
+// @Summary Revoke Calendar Token
+// @Description Revoke the secret token and disable the calendar feed
+// @Security BearerAuth
+// @Tags Calendar
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/user/profile/calendar-token [DELETE]
+func (r *rest) RevokeCalendarToken(ctx *gin.Context) {
+	if err := r.uc.Calendar.RevokeToken(ctx.Request.Context()); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
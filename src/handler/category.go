@@ -33,12 +33,13 @@ func (r *rest) CreateCategory(ctx *gin.Context) {
 }
 
 // @Summary Get Category List
-// @Description Get list all Category
+// @Description Get list all Category, the global categories and the categories of the workspaces of the user
 // @Security BearerAuth
 // @Tags Category
 // @Param limit query integer false "limit"
 // @Param page query integer false "page"
 // @Param disableLimit query boolean false "disable limit" Enums(true, false)
+// @Param workspaceId query integer false "Filter category of the workspace"
 // @Produce json
 // @Success 200 {object} entity.HTTPResp{data=[]entity.Category{}}
 // @Failure 500 {object} entity.HTTPResp{}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create Task Comment
+// @Description Create new Comment on the Task
+// @Security BearerAuth
+// @Tags Comment
+// @Param task_id path integer true "task id"
+// @Param data body entity.CreateTaskCommentParam true "Input New Comment Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TaskComment{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/comments [POST]
+func (r *rest) CreateTaskComment(ctx *gin.Context) {
+	var param entity.CreateTaskCommentParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var taskParam entity.TaskParam
+	if err := r.BindUri(ctx, &taskParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	comment, err := r.uc.Comment.Create(ctx.Request.Context(), taskParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, comment, nil)
+}
+
+// @Summary Get Task Comment List
+// @Description Get list of comments on the Task, oldest first
+// @Security BearerAuth
+// @Tags Comment
+// @Param task_id path integer true "task id"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.TaskComment{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/comments [GET]
+func (r *rest) GetListTaskComment(ctx *gin.Context) {
+	var param entity.TaskCommentParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	comments, pg, err := r.uc.Comment.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, comments, pg)
+}
+
+// @Summary Update Task Comment
+// @Description Edit the Comment, only the author can edit it
+// @Security BearerAuth
+// @Tags Comment
+// @Param task_id path integer true "task id"
+// @Param comment_id path integer true "comment id"
+// @Param data body entity.UpdateTaskCommentParam true "Input Comment Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/comments/{comment_id} [PUT]
+func (r *rest) UpdateTaskComment(ctx *gin.Context) {
+	var updateParam entity.UpdateTaskCommentParam
+	if err := r.Bind(ctx, &updateParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.TaskCommentParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Comment.Update(ctx.Request.Context(), updateParam, selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Delete Task Comment
+// @Description Delete the Comment, only the author and admin can delete it
+// @Security BearerAuth
+// @Tags Comment
+// @Param task_id path integer true "task id"
+// @Param comment_id path integer true "comment id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/comments/{comment_id} [DELETE]
+func (r *rest) DeleteTaskComment(ctx *gin.Context) {
+	var selectParam entity.TaskCommentParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Comment.Delete(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
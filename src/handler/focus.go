@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Start Focus Session
+// @Description Start a pomodoro on the Task, the work and break lengths default to the configured ones. The session is completed by the server once the work part is over and its break follows, starting a new session skips the break
+// @Security BearerAuth
+// @Tags Focus
+// @Param task_id path integer true "task id"
+// @Param data body entity.CreateFocusSessionParam false "Work And Break Lengths"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.FocusSession{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/focus [POST]
+func (r *rest) StartFocusSession(ctx *gin.Context) {
+	// The lengths are optional, so is the body
+	var param entity.CreateFocusSessionParam
+	if ctx.Request.ContentLength > 0 {
+		if err := r.Bind(ctx, &param); err != nil {
+			r.httpRespError(ctx, err)
+			return
+		}
+	}
+
+	var taskParam entity.TaskParam
+	if err := r.BindUri(ctx, &taskParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	session, err := r.uc.Focus.Start(ctx.Request.Context(), taskParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, session, nil)
+}
+
+// @Summary Complete Focus Session
+// @Description Complete the Focus Session whose work part is over, its phase is break until breakMinutes after the work part
+// @Security BearerAuth
+// @Tags Focus
+// @Param focus_session_id path integer true "focus session id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.FocusSession{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/focus/{focus_session_id}/complete [POST]
+func (r *rest) CompleteFocusSession(ctx *gin.Context) {
+	var param entity.FocusSessionParam
+	if err := r.BindUri(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	session, err := r.uc.Focus.Complete(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, session, nil)
+}
+
+// @Summary Interrupt Focus Session
+// @Description Stop the running Focus Session before its work part is over
+// @Security BearerAuth
+// @Tags Focus
+// @Param focus_session_id path integer true "focus session id"
+// @Param data body entity.InterruptFocusSessionParam false "Interrupt Reason"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.FocusSession{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/focus/{focus_session_id}/interrupt [POST]
+func (r *rest) InterruptFocusSession(ctx *gin.Context) {
+	// The reason is optional, so is the body
+	var param entity.InterruptFocusSessionParam
+	if ctx.Request.ContentLength > 0 {
+		if err := r.Bind(ctx, &param); err != nil {
+			r.httpRespError(ctx, err)
+			return
+		}
+	}
+
+	var selectParam entity.FocusSessionParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	session, err := r.uc.Focus.Interrupt(ctx.Request.Context(), selectParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, session, nil)
+}
+
+// @Summary Get Current Focus Session
+// @Description Get the running Focus Session of the user, or the completed one while its break is running
+// @Security BearerAuth
+// @Tags Focus
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.FocusSession{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/focus/current [GET]
+func (r *rest) GetCurrentFocusSession(ctx *gin.Context) {
+	session, err := r.uc.Focus.GetCurrent(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, session, nil)
+}
+
+// @Summary Get Focus Session List
+// @Description Get list of the Focus Sessions of the user, latest first
+// @Security BearerAuth
+// @Tags Focus
+// @Param taskId query integer false "only the sessions of the task"
+// @Param state query string false "only the sessions in the state" Enums(running, completed, interrupted)
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.FocusSession{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/focus [GET]
+func (r *rest) GetListFocusSession(ctx *gin.Context) {
+	var param entity.FocusSessionParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	sessions, pg, err := r.uc.Focus.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, sessions, pg)
+}
+
+// @Summary Get Daily Focus Report
+// @Description Get the focus minutes and the number of completed and interrupted sessions of the user per day
+// @Security BearerAuth
+// @Tags Focus
+// @Param from query string false "date (2006-01-02) or RFC 3339 time, default is 7 days before to"
+// @Param to query string false "date (2006-01-02) or RFC 3339 time, the whole day is included when only the date is given, default is now"
+// @Param timezone query string false "IANA time zone of the dates and the days, e.g. Asia/Jakarta, default is UTC"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.FocusDay{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/focus/daily [GET]
+func (r *rest) GetDailyFocus(ctx *gin.Context) {
+	var reportQuery entity.FocusReportQuery
+	if err := r.BindQuery(ctx, &reportQuery); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var param entity.FocusReportParam
+	var err error
+	param.Location, param.From, param.To, err = parseQueryRange(reportQuery.Timezone, reportQuery.From, reportQuery.To)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	days, err := r.uc.Focus.GetDaily(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, days, nil)
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get Habit List
+// @Description Get the daily and weekly periodic tasks of the user as habits with their streaks and completion rate
+// @Security BearerAuth
+// @Tags Habit
+// @Param periodic query string false "only the daily or weekly habits" Enums(daily, weekly)
+// @Param timezone query string false "IANA time zone of today, e.g. Asia/Jakarta, default is UTC"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Habit{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/habit [GET]
+func (r *rest) GetListHabit(ctx *gin.Context) {
+	var param entity.HabitParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	habits, pg, err := r.uc.Habit.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, habits, pg)
+}
+
+// @Summary Get Habit
+// @Description Get the habit with its current and longest streak and completion rate, any task of the series can be given
+// @Security BearerAuth
+// @Tags Habit
+// @Param habit_id path integer true "habit id"
+// @Param timezone query string false "IANA time zone of today, e.g. Asia/Jakarta, default is UTC"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Habit{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/habit/{habit_id} [GET]
+func (r *rest) GetHabit(ctx *gin.Context) {
+	var param entity.HabitParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	habit, err := r.uc.Habit.Get(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, habit, nil)
+}
+
+// @Summary Get Habit History
+// @Description Get the check ins of the habit per day for a calendar heatmap
+// @Security BearerAuth
+// @Tags Habit
+// @Param habit_id path integer true "habit id"
+// @Param from query string false "date (2006-01-02) or RFC 3339 time, default is 365 days before to"
+// @Param to query string false "date (2006-01-02) or RFC 3339 time, the whole day is included when only the date is given, default is now"
+// @Param timezone query string false "IANA time zone of the dates and the days, e.g. Asia/Jakarta, default is UTC"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.HabitHistory{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/habit/{habit_id}/history [GET]
+func (r *rest) GetHabitHistory(ctx *gin.Context) {
+	var habitParam entity.HabitParam
+	if err := r.BindUri(ctx, &habitParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var historyQuery entity.HabitHistoryQuery
+	if err := r.BindQuery(ctx, &historyQuery); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	param := entity.HabitHistoryParam{ID: habitParam.ID}
+	var err error
+	param.Location, param.From, param.To, err = parseQueryRange(historyQuery.Timezone, historyQuery.From, historyQuery.To)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	history, err := r.uc.Habit.GetHistory(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, history, nil)
+}
+
+// @Summary Check In Habit
+// @Description Mark the habit as done on the day, the task is not updated so no new task is created
+// @Security BearerAuth
+// @Tags Habit
+// @Param habit_id path integer true "habit id"
+// @Param data body entity.HabitCheckInRequest false "Day Of The Check In, default is today"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.HabitCheckIn{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/habit/{habit_id}/checkin [POST]
+func (r *rest) CheckInHabit(ctx *gin.Context) {
+	// The day is optional, so is the body
+	var param entity.HabitCheckInRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := r.Bind(ctx, &param); err != nil {
+			r.httpRespError(ctx, err)
+			return
+		}
+	}
+
+	var habitParam entity.HabitParam
+	if err := r.BindUri(ctx, &habitParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	checkIn, err := r.uc.Habit.CheckIn(ctx.Request.Context(), habitParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, checkIn, nil)
+}
+
+// @Summary Undo Habit Check In
+// @Description Delete the check in of the habit on the day
+// @Security BearerAuth
+// @Tags Habit
+// @Param habit_id path integer true "habit id"
+// @Param date query string false "day of the check in (2006-01-02), default is today"
+// @Param timezone query string false "IANA time zone of today, e.g. Asia/Jakarta, default is UTC"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/habit/{habit_id}/checkin [DELETE]
+func (r *rest) UndoCheckInHabit(ctx *gin.Context) {
+	var param entity.HabitCheckInRequest
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var habitParam entity.HabitParam
+	if err := r.BindUri(ctx, &habitParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Habit.UndoCheckIn(ctx.Request.Context(), habitParam, param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
@@ -231,6 +231,7 @@ func (r *rest) VerifyUser(ctx *gin.Context) {
 		User: user.ConvertToAuthUser(),
 	})
 	c = appcontext.SetUserId(c, int(user.ID))
+	c = entity.SetUserRoleID(c, user.RoleId.Int64)
 	ctx.Request = ctx.Request.WithContext(c)
 
 	ctx.Next()
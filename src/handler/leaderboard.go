@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Get Leaderboard
+// @Description Get the ranking of the users by XP or done tasks, the users who opted out are not shown
+// @Security BearerAuth
+// @Tags Leaderboard
+// @Param scope query string false "scope of the leaderboard, default is global" Enums(global, workspace)
+// @Param workspaceId query integer false "workspace id, required by the workspace scope"
+// @Param metric query string false "metric of the ranking, default is xp" Enums(xp, tasks)
+// @Param period query string false "period of the ranking, default is weekly" Enums(weekly, alltime)
+// @Param limit query integer false "limit, default is 10, max is 100"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.LeaderboardEntry{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/leaderboard [GET]
+func (r *rest) GetLeaderboard(ctx *gin.Context) {
+	var param entity.LeaderboardParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	entries, pg, err := r.uc.Leaderboard.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, entries, pg)
+}
+
+// @Summary Get My Leaderboard Rank
+// @Description Get the rank and score of the user on the leaderboard, the rank is zero when the user is not ranked
+// @Security BearerAuth
+// @Tags Leaderboard
+// @Param scope query string false "scope of the leaderboard, default is global" Enums(global, workspace)
+// @Param workspaceId query integer false "workspace id, required by the workspace scope"
+// @Param metric query string false "metric of the ranking, default is xp" Enums(xp, tasks)
+// @Param period query string false "period of the ranking, default is weekly" Enums(weekly, alltime)
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.LeaderboardEntry{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/leaderboard/me [GET]
+func (r *rest) GetMyLeaderboardRank(ctx *gin.Context) {
+	var param entity.LeaderboardParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	entry, err := r.uc.Leaderboard.GetMe(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, entry, nil)
+}
+
+// @Summary Get Leaderboard Settings
+// @Description Get the leaderboard privacy settings of the user
+// @Security BearerAuth
+// @Tags Leaderboard
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.LeaderboardSettings{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/leaderboard/settings [GET]
+func (r *rest) GetLeaderboardSettings(ctx *gin.Context) {
+	settings, err := r.uc.Leaderboard.GetSettings(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, settings, nil)
+}
+
+// @Summary Update Leaderboard Settings
+// @Description Opt out of or back in to the leaderboards, opting out removes the user from every leaderboard
+// @Security BearerAuth
+// @Tags Leaderboard
+// @Param data body entity.LeaderboardSettings true "Leaderboard Settings"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.LeaderboardSettings{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/leaderboard/settings [PUT]
+func (r *rest) UpdateLeaderboardSettings(ctx *gin.Context) {
+	var param entity.LeaderboardSettings
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	settings, err := r.uc.Leaderboard.UpdateSettings(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, settings, nil)
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create Task Reminder
+// @Description Create new Reminder on the Task, either offsetMinutes before the due time or at timeOfDay on the due day in the timezone of the user
+// @Security BearerAuth
+// @Tags Reminder
+// @Param task_id path integer true "task id"
+// @Param data body entity.CreateTaskReminderParam true "Input New Reminder Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TaskReminder{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/reminders [POST]
+func (r *rest) CreateTaskReminder(ctx *gin.Context) {
+	var param entity.CreateTaskReminderParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var taskParam entity.TaskParam
+	if err := r.BindUri(ctx, &taskParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	reminder, err := r.uc.Reminder.Create(ctx.Request.Context(), taskParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, reminder, nil)
+}
+
+// @Summary Get Task Reminder List
+// @Description Get list of reminders on the Task, earliest first
+// @Security BearerAuth
+// @Tags Reminder
+// @Param task_id path integer true "task id"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.TaskReminder{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/reminders [GET]
+func (r *rest) GetListTaskReminder(ctx *gin.Context) {
+	var param entity.TaskReminderParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	reminders, pg, err := r.uc.Reminder.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, reminders, pg)
+}
+
+// @Summary Delete Task Reminder
+// @Description Delete the Reminder from the Task
+// @Security BearerAuth
+// @Tags Reminder
+// @Param task_id path integer true "task id"
+// @Param reminder_id path integer true "reminder id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/reminders/{reminder_id} [DELETE]
+func (r *rest) DeleteTaskReminder(ctx *gin.Context) {
+	var selectParam entity.TaskReminderParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Reminder.Delete(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
@@ -206,6 +206,7 @@ func (r *rest) Register() {
 	// public api
 	publicv1 := r.http.Group("/public/v1/", commonPublicMiddlewares...)
 	publicv1.POST("/register", r.RegisterNewUserWithoutToken)
+	publicv1.GET("/calendar/:token", r.GetCalendarFeed)
 
 	// auth api
 	authv1 := r.http.Group("/auth/v1", commonPublicMiddlewares...)
@@ -219,14 +220,47 @@ func (r *rest) Register() {
 	v1.GET("/user/:user_id", r.GetUserByID)
 	v1.GET("/user/profile", r.UserProfile)
 	v1.PUT("/user/profile", r.UpdateUserProfile)
+	v1.GET("/user/profile/progress", r.UserProgress)
 	v1.DELETE("/user/profile", r.UserSelfDelete)
 	v1.PUT("/user/profile/change-password", r.UserChangePassword)
+	v1.POST("/user/profile/calendar-token", r.GenerateCalendarToken)
+	v1.DELETE("/user/profile/calendar-token", r.RevokeCalendarToken)
 
 	// user management admin api
 	v1.GET("/admin/user", r.isAdmin, r.GetListUserAsAdmin)
 	v1.DELETE("/admin/user/:user_id", r.DeleteUser)
 	v1.PUT("/admin/user/:user_id", r.isAdmin, r.UpdateUser)
 
+	// achievement
+	v1.GET("/achievements", r.GetListAchievement)
+	v1.POST("/admin/achievements", r.isAdmin, r.CreateAchievement)
+	v1.PUT("/admin/achievements/:achievement_id", r.isAdmin, r.UpdateAchievement)
+	v1.DELETE("/admin/achievements/:achievement_id", r.isAdmin, r.DeleteAchievement)
+
+	// leaderboard
+	v1.GET("/leaderboard", r.GetLeaderboard)
+	v1.GET("/leaderboard/me", r.GetMyLeaderboardRank)
+	v1.GET("/leaderboard/settings", r.GetLeaderboardSettings)
+	v1.PUT("/leaderboard/settings", r.UpdateLeaderboardSettings)
+
+	// workspace
+	v1.GET("/workspace", r.GetListWorkspace)
+	v1.POST("/workspace", r.CreateWorkspace)
+	v1.GET("/workspace/invitations", r.GetMyWorkspaceInvitations)
+	v1.POST("/workspace/invitations/:invitation_id/accept", r.AcceptWorkspaceInvitation)
+	v1.POST("/workspace/invitations/:invitation_id/decline", r.DeclineWorkspaceInvitation)
+	v1.GET("/workspace/:workspace_id", r.GetWorkspaceByID)
+	v1.PUT("/workspace/:workspace_id", r.UpdateWorkspace)
+	v1.DELETE("/workspace/:workspace_id", r.DeleteWorkspace)
+	v1.POST("/workspace/:workspace_id/transfer", r.TransferWorkspace)
+	v1.POST("/workspace/:workspace_id/leave", r.LeaveWorkspace)
+	v1.GET("/workspace/:workspace_id/members", r.GetListWorkspaceMember)
+	v1.PUT("/workspace/:workspace_id/members/:user_id", r.UpdateWorkspaceMember)
+	v1.DELETE("/workspace/:workspace_id/members/:user_id", r.DeleteWorkspaceMember)
+	v1.GET("/workspace/:workspace_id/invitations", r.GetListWorkspaceInvitation)
+	v1.POST("/workspace/:workspace_id/invitations", r.CreateWorkspaceInvitation)
+	v1.DELETE("/workspace/:workspace_id/invitations/:invitation_id", r.DeleteWorkspaceInvitation)
+
 	// category
 	v1.GET("/category", r.GetListCategory)
 	v1.POST("/category", r.CreateCategory)
@@ -237,9 +271,86 @@ func (r *rest) Register() {
 	// task
 	v1.GET("/task", r.GetListTask)
 	v1.POST("/task", r.CreateTask)
+	v1.POST("/task/bulk", r.BulkTask)
+	v1.POST("/task/import", r.ImportTask)
+	v1.GET("/task/export", r.ExportTask)
 	v1.GET("/task/:task_id", r.GetTaskById)
 	v1.PUT("/task/:task_id", r.UpdateTask)
 	v1.DELETE("/task/:task_id", r.DeleteTask)
+	v1.GET("/task/:task_id/occurrences", r.GetTaskOccurrences)
+	v1.GET("/task/:task_id/subtasks", r.GetListSubtask)
+	v1.POST("/task/:task_id/subtasks", r.CreateSubtask)
+	v1.GET("/task/:task_id/blockers", r.GetListTaskBlocker)
+	v1.POST("/task/:task_id/blockers", r.CreateTaskBlocker)
+	v1.DELETE("/task/:task_id/blockers/:blocker_id", r.DeleteTaskBlocker)
+	v1.GET("/task/:task_id/history", r.GetTaskStatusHistory)
+	v1.PUT("/task/:task_id/assignee", r.AssignTask)
+	v1.DELETE("/task/:task_id/assignee", r.UnassignTask)
+	v1.PUT("/task/:task_id/move", r.MoveTask)
+
+	// board
+	v1.GET("/board", r.GetListBoard)
+	v1.POST("/board", r.CreateBoard)
+	v1.GET("/board/:board_id", r.GetBoardByID)
+	v1.PUT("/board/:board_id", r.UpdateBoard)
+	v1.DELETE("/board/:board_id", r.DeleteBoard)
+	v1.POST("/board/:board_id/columns", r.CreateBoardColumn)
+	v1.PUT("/board/:board_id/columns/:column_id", r.UpdateBoardColumn)
+	v1.DELETE("/board/:board_id/columns/:column_id", r.DeleteBoardColumn)
+	v1.PUT("/board/:board_id/tasks/:task_id/move", r.MoveBoardTask)
+
+	// comment
+	v1.GET("/task/:task_id/comments", r.GetListTaskComment)
+	v1.POST("/task/:task_id/comments", r.CreateTaskComment)
+	v1.PUT("/task/:task_id/comments/:comment_id", r.UpdateTaskComment)
+	v1.DELETE("/task/:task_id/comments/:comment_id", r.DeleteTaskComment)
+
+	// attachment
+	v1.GET("/task/:task_id/attachments", r.GetListTaskAttachment)
+	v1.POST("/task/:task_id/attachments", r.CreateTaskAttachment)
+	v1.GET("/task/:task_id/attachments/:attachment_id/download", r.DownloadTaskAttachment)
+	v1.DELETE("/task/:task_id/attachments/:attachment_id", r.DeleteTaskAttachment)
+
+	// reminder
+	v1.GET("/task/:task_id/reminders", r.GetListTaskReminder)
+	v1.POST("/task/:task_id/reminders", r.CreateTaskReminder)
+	v1.DELETE("/task/:task_id/reminders/:reminder_id", r.DeleteTaskReminder)
+
+	// time entry
+	v1.GET("/timer", r.GetRunningTimer)
+	v1.POST("/task/:task_id/timer/start", r.StartTaskTimer)
+	v1.POST("/task/:task_id/timer/stop", r.StopTaskTimer)
+	v1.GET("/task/:task_id/time-entries", r.GetListTaskTimeEntry)
+	v1.POST("/task/:task_id/time-entries", r.CreateTaskTimeEntry)
+	v1.DELETE("/task/:task_id/time-entries/:time_entry_id", r.DeleteTaskTimeEntry)
+	v1.GET("/time-entry/total", r.GetTimeEntryTotal)
+
+	// focus
+	v1.POST("/task/:task_id/focus", r.StartFocusSession)
+	v1.GET("/focus", r.GetListFocusSession)
+	v1.GET("/focus/current", r.GetCurrentFocusSession)
+	v1.GET("/focus/daily", r.GetDailyFocus)
+	v1.POST("/focus/:focus_session_id/complete", r.CompleteFocusSession)
+	v1.POST("/focus/:focus_session_id/interrupt", r.InterruptFocusSession)
+
+	// habit
+	v1.GET("/habit", r.GetListHabit)
+	v1.GET("/habit/:habit_id", r.GetHabit)
+	v1.GET("/habit/:habit_id/history", r.GetHabitHistory)
+	v1.POST("/habit/:habit_id/checkin", r.CheckInHabit)
+	v1.DELETE("/habit/:habit_id/checkin", r.UndoCheckInHabit)
+
+	// tag
+	v1.GET("/tag", r.GetListTag)
+	v1.POST("/tag", r.CreateTag)
+	v1.GET("/tag/:tag_id", r.GetTagByID)
+	v1.PUT("/tag/:tag_id", r.UpdateTag)
+	v1.DELETE("/tag/:tag_id", r.DeleteTag)
+	v1.POST("/task/:task_id/tags", r.CreateTaskTag)
+	v1.DELETE("/task/:task_id/tags/:tag_id", r.DeleteTaskTag)
+
+	// search
+	v1.GET("/search", r.Search)
 
 	// role
 	v1.GET("/role", r.isAdmin, r.GetListRole)
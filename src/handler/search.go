@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Search
+// @Description Search tasks, comments and categories, the hits are ranked by relevance
+// @Security BearerAuth
+// @Tags Search
+// @Param q query string true "search query"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.SearchResult{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/search [GET]
+func (r *rest) Search(ctx *gin.Context) {
+	var param entity.SearchParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	results, pg, err := r.uc.Search.Search(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, results, pg)
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create Tag
+// @Description Create new entry for Tag
+// @Security BearerAuth
+// @Tags Tag
+// @Param data body entity.CreateTagParam true "Input New Tag Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Tag{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/tag [post]
+func (r *rest) CreateTag(ctx *gin.Context) {
+	var param entity.CreateTagParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	tag, err := r.uc.Tag.Create(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, tag, nil)
+}
+
+// @Summary Get Tag List
+// @Description Get list all Tag
+// @Security BearerAuth
+// @Tags Tag
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Param disableLimit query boolean false "disable limit" Enums(true, false)
+// @Param name query string false "Filter tag by name"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Tag{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/tag [GET]
+func (r *rest) GetListTag(ctx *gin.Context) {
+	var param entity.TagParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	tags, pg, err := r.uc.Tag.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, tags, pg)
+}
+
+// @Summary Get Tag By ID
+// @Description Get Tag details by Tag ID
+// @Security BearerAuth
+// @Tags Tag
+// @Param tag_id path integer true "Tag id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Tag{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/tag/{tag_id} [GET]
+func (r *rest) GetTagByID(ctx *gin.Context) {
+	var param entity.TagParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	tag, err := r.uc.Tag.Get(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, tag, nil)
+}
+
+// @Summary Update One Tag
+// @Description Update one tag detail
+// @Security BearerAuth
+// @Tags Tag
+// @Param tag_id path integer true "Tag id"
+// @Param tag body entity.UpdateTagParam true "tag data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Tag{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/tag/{tag_id} [PUT]
+func (r *rest) UpdateTag(ctx *gin.Context) {
+	var updateParam entity.UpdateTagParam
+	if err := r.Bind(ctx, &updateParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.TagParam
+	if err := r.BindParams(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Tag.Update(ctx.Request.Context(), updateParam, selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Delete Tag
+// @Description Soft delete tag data
+// @Security BearerAuth
+// @Tags Tag
+// @Param tag_id path integer true "tag id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Tag{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/tag/{tag_id} [DELETE]
+func (r *rest) DeleteTag(ctx *gin.Context) {
+	var selectParam entity.TagParam
+	if err := r.BindParams(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Tag.Delete(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Add Tag To Task
+// @Description Label the Task with the Tag
+// @Security BearerAuth
+// @Tags Tag
+// @Param task_id path integer true "task id"
+// @Param data body entity.CreateTaskTagParam true "Input Tag"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TaskTag{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/tags [POST]
+func (r *rest) CreateTaskTag(ctx *gin.Context) {
+	var param entity.CreateTaskTagParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var taskParam entity.TaskParam
+	if err := r.BindUri(ctx, &taskParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	taskTag, err := r.uc.Tag.AddToTask(ctx.Request.Context(), taskParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, taskTag, nil)
+}
+
+// @Summary Remove Tag From Task
+// @Description Remove the Tag label from the Task
+// @Security BearerAuth
+// @Tags Tag
+// @Param task_id path integer true "task id"
+// @Param tag_id path integer true "tag id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/tags/{tag_id} [DELETE]
+func (r *rest) DeleteTaskTag(ctx *gin.Context) {
+	var selectParam entity.TaskTagParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Tag.RemoveFromTask(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
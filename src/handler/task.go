@@ -1,19 +1,25 @@
 package handler
 
 import (
+	"fmt"
+	"net/http"
+	"time"
+
 	"github.com/adiatma85/gg-project/src/business/entity"
 	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
 	"github.com/gin-gonic/gin"
 )
 
 // @Summary Create Task
-// @Description Create new entry for Task
+// @Description Create new entry for Task, a subtask is assigned to the assignee of its parent unless the owner assigns it to someone else
 // @Security BearerAuth
 // @Tags Task
 // @Param data body entity.CreateTaskParam true "Input New Task Data"
 // @Produce json
 // @Success 200 {object} entity.HTTPResp{data=entity.Task{}}
 // @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
 // @Failure 500 {object} entity.HTTPResp{}
 // @Router /v1/task [post]
 func (r *rest) CreateTask(ctx *gin.Context) {
@@ -39,14 +45,26 @@ func (r *rest) CreateTask(ctx *gin.Context) {
 // @Param limit query integer false "limit"
 // @Param page query integer false "page"
 // @Param disableLimit query boolean false "disable limit" Enums(true, false)
-// @Param taskStatus query string false "Filter task by status" Enums(ongoing, todo, done)
+// @Param taskStatus query []string false "Filter task by status, comma separated or repeated" Enums(ongoing, todo, done) collectionFormat(csv)
+// @Param categoryId query []integer false "Filter task by category id, comma separated or repeated" collectionFormat(csv)
+// @Param priorityMin query integer false "Filter task with priority greater than or equal to the value"
+// @Param dueFrom query string false "Filter task due on or after the date (2006-01-02) or RFC 3339 time"
+// @Param dueTo query string false "Filter task due on or before the date (2006-01-02) or RFC 3339 time"
+// @Param overdue query boolean false "Only unfinished task which is past its due time" Enums(true, false)
+// @Param assignedToMe query boolean false "Only task which is assigned to the user" Enums(true, false)
+// @Param workspaceId query integer false "Filter task of the workspace"
+// @Param parentTaskId query integer false "Filter subtasks of the parent task"
+// @Param topLevelOnly query boolean false "Exclude subtasks" Enums(true, false)
+// @Param tags query string false "Filter task by tag names, e.g. any:urgent,client-x or all:urgent,client-x"
+// @Param sortBy query []string false "Sort by the fields, prefix with - for descending, e.g. -priority,dueTime" Enums(id, title, priority, taskStatus, dueTime, position, createdAt, updatedAt) collectionFormat(csv)
 // @Produce json
 // @Success 200 {object} entity.HTTPResp{data=[]entity.Task{}}
+// @Failure 400 {object} entity.HTTPResp{}
 // @Failure 500 {object} entity.HTTPResp{}
 // @Router /v1/task [GET]
 func (r *rest) GetListTask(ctx *gin.Context) {
 	var param entity.TaskParam
-	if err := r.BindParams(ctx, &param); err != nil {
+	if err := r.bindTaskListParam(ctx, &param); err != nil {
 		r.httpRespError(ctx, err)
 		return
 	}
@@ -117,12 +135,13 @@ func (r *rest) UpdateTask(ctx *gin.Context) {
 }
 
 // @Summary Delete Task
-// @Description Soft delete Task data
+// @Description Soft delete Task data together with its subtasks, only the owner can delete the Task
 // @Security BearerAuth
 // @Tags Task
 // @Param task_id path integer true "task id"
 // @Produce json
 // @Success 200 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
 // @Failure 500 {object} entity.HTTPResp{}
 // @Router /v1/task/{task_id} [DELETE]
 func (r *rest) DeleteTask(ctx *gin.Context) {
@@ -139,3 +158,454 @@ func (r *rest) DeleteTask(ctx *gin.Context) {
 
 	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
 }
+
+// @Summary Get Task Occurrences
+// @Description Expand the recurrence rule (or periodic) of the Task into due times inside the date window
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "Task id"
+// @Param from query string false "Window start date (inclusive), default today" example(2024-01-01)
+// @Param to query string false "Window end date (inclusive), default one month after from" example(2024-01-31)
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.TaskOccurrence{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/occurrences [GET]
+func (r *rest) GetTaskOccurrences(ctx *gin.Context) {
+	var param entity.TaskOccurrenceParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	occurrences, err := r.uc.Task.GetOccurrences(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, occurrences, nil)
+}
+
+// @Summary Create Subtask
+// @Description Create new Task under the parent Task
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "Parent task id"
+// @Param data body entity.CreateTaskParam true "Input New Subtask Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Task{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/subtasks [POST]
+func (r *rest) CreateSubtask(ctx *gin.Context) {
+	var param entity.CreateTaskParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var parentParam entity.TaskParam
+	if err := r.BindUri(ctx, &parentParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	task, err := r.uc.Task.CreateSubtask(ctx.Request.Context(), parentParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, task, nil)
+}
+
+// @Summary Get Subtask List
+// @Description Get list of subtasks under the parent Task
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "Parent task id"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Param disableLimit query boolean false "disable limit" Enums(true, false)
+// @Param taskStatus query []string false "Filter task by status, comma separated or repeated" Enums(ongoing, todo, done) collectionFormat(csv)
+// @Param sortBy query []string false "Sort by the fields, prefix with - for descending, e.g. -priority,dueTime" Enums(id, title, priority, taskStatus, dueTime, position, createdAt, updatedAt) collectionFormat(csv)
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Task{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/subtasks [GET]
+func (r *rest) GetListSubtask(ctx *gin.Context) {
+	var param entity.TaskParam
+	if err := r.bindTaskListParam(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	tasks, pg, err := r.uc.Task.GetSubtasks(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, tasks, pg)
+}
+
+// @Summary Get Task Blockers
+// @Description Get list of tasks which block the Task
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "task id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Task{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/blockers [GET]
+func (r *rest) GetListTaskBlocker(ctx *gin.Context) {
+	var param entity.TaskParam
+	if err := r.BindUri(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	tasks, err := r.uc.Task.GetBlockers(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, tasks, nil)
+}
+
+// @Summary Add Task Blocker
+// @Description Mark the Task as blocked by another Task
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "task id"
+// @Param data body entity.CreateTaskDependencyParam true "Input Blocker Task"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TaskDependency{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/blockers [POST]
+func (r *rest) CreateTaskBlocker(ctx *gin.Context) {
+	var param entity.CreateTaskDependencyParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.TaskParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	dependency, err := r.uc.Task.AddBlocker(ctx.Request.Context(), selectParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, dependency, nil)
+}
+
+// @Summary Remove Task Blocker
+// @Description Remove the blocker from the Task
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "task id"
+// @Param blocker_id path integer true "blocker task id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/blockers/{blocker_id} [DELETE]
+func (r *rest) DeleteTaskBlocker(ctx *gin.Context) {
+	var selectParam entity.TaskDependencyParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Task.RemoveBlocker(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Assign Task
+// @Description Assign or reassign the Task to another user, only the owner can assign the Task
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "task id"
+// @Param data body entity.AssignTaskParam true "Assignee"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Task{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/assignee [PUT]
+func (r *rest) AssignTask(ctx *gin.Context) {
+	var param entity.AssignTaskParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.TaskParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	task, err := r.uc.Task.Assign(ctx.Request.Context(), selectParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, task, nil)
+}
+
+// @Summary Unassign Task
+// @Description Remove the assignee of the Task, both the owner and the assignee can unassign the Task
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "task id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Task{}}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/assignee [DELETE]
+func (r *rest) UnassignTask(ctx *gin.Context) {
+	var selectParam entity.TaskParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	task, err := r.uc.Task.Unassign(ctx.Request.Context(), selectParam)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, task, nil)
+}
+
+// @Summary Move Task
+// @Description Move the Task right after the after Task or right before the before Task, only the position of the moved Task is changed unless the positions have to be rebalanced. Sort the list by position for the manual order
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "task id"
+// @Param data body entity.MoveTaskParam true "Anchors of the Task"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Task{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/move [PUT]
+func (r *rest) MoveTask(ctx *gin.Context) {
+	var param entity.MoveTaskParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.TaskParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	task, err := r.uc.Task.Move(ctx.Request.Context(), selectParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, task, nil)
+}
+
+// @Summary Get Task Status History
+// @Description Get the status transitions of the Task, oldest first
+// @Security BearerAuth
+// @Tags Task
+// @Param task_id path integer true "task id"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.TaskStatusHistory{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/history [GET]
+func (r *rest) GetTaskStatusHistory(ctx *gin.Context) {
+	var param entity.TaskStatusHistoryParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	histories, pg, err := r.uc.Task.GetStatusHistory(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, histories, pg)
+}
+
+// @Summary Bulk Task
+// @Description Apply status, category, priority or delete operations to many Tasks in one transaction. Task which can not be updated is reported in its result
+// @Security BearerAuth
+// @Tags Task
+// @Param data body entity.BulkTaskParam true "Bulk Operations"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.BulkTaskResult{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/bulk [post]
+func (r *rest) BulkTask(ctx *gin.Context) {
+	var param entity.BulkTaskParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	results, err := r.uc.Task.Bulk(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, results, nil)
+}
+
+// @Summary Import Task
+// @Description Import Tasks from an iCalendar (VTODO), CSV or JSON export file. Every row is validated first, Tasks and the missing Categories are only created when all rows are valid and it is not a dry run
+// @Security BearerAuth
+// @Tags Task
+// @Accept multipart/form-data
+// @Param file formData file true "File to import"
+// @Param format query string false "file format, default is taken from the file extension" Enums(ics, csv, json)
+// @Param mapping query string false "CSV only, JSON object of task field to CSV header, e.g. {\"title\":\"Name\"}"
+// @Param dryRun query boolean false "only validate the file"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TaskImportResult{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/import [post]
+func (r *rest) ImportTask(ctx *gin.Context) {
+	var param entity.TaskImportParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, err.Error()))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, err.Error()))
+		return
+	}
+	defer file.Close()
+
+	result, err := r.uc.Task.Import(ctx.Request.Context(), param, fileHeader.Filename, file)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, result, nil)
+}
+
+// @Summary Export Task
+// @Description Download the Tasks as CSV, JSON or iCalendar (VTODO) file, the Tasks are filtered the same way as the Task list and streamed without pagination
+// @Security BearerAuth
+// @Tags Task
+// @Param format query string false "file format, default is json" Enums(csv, json, ics)
+// @Param taskStatus query []string false "Filter task by status, comma separated or repeated" Enums(ongoing, todo, done) collectionFormat(csv)
+// @Param categoryId query []integer false "Filter task by category id, comma separated or repeated" collectionFormat(csv)
+// @Param priorityMin query integer false "Filter task with priority greater than or equal to the value"
+// @Param dueFrom query string false "Filter task due on or after the date (2006-01-02) or RFC 3339 time"
+// @Param dueTo query string false "Filter task due on or before the date (2006-01-02) or RFC 3339 time"
+// @Param overdue query boolean false "Only unfinished task which is past its due time" Enums(true, false)
+// @Param assignedToMe query boolean false "Only task which is assigned to the user" Enums(true, false)
+// @Param workspaceId query integer false "Filter task of the workspace"
+// @Param parentTaskId query integer false "Filter subtasks of the parent task"
+// @Param topLevelOnly query boolean false "Exclude subtasks" Enums(true, false)
+// @Param tags query string false "Filter task by tag names, e.g. any:urgent,client-x or all:urgent,client-x"
+// @Param sortBy query []string false "Sort by the fields, prefix with - for descending, e.g. -priority,dueTime" Enums(id, title, priority, taskStatus, dueTime, position, createdAt, updatedAt) collectionFormat(csv)
+// @Produce json
+// @Produce text/csv
+// @Produce text/calendar
+// @Success 200 {string} string
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/export [GET]
+func (r *rest) ExportTask(ctx *gin.Context) {
+	var param entity.TaskParam
+	if err := r.bindTaskListParam(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var exportParam entity.TaskExportParam
+	if err := r.BindQuery(ctx, &exportParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	switch exportParam.Format {
+	case "":
+		exportParam.Format = entity.TaskFileFormatJSON
+	case entity.TaskFileFormatCSV, entity.TaskFileFormatJSON, entity.TaskFileFormatICS:
+	default:
+		r.httpRespError(ctx, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid format %q, must be one of csv, json, ics", exportParam.Format)))
+		return
+	}
+
+	// The response is only started with the first task, so an error before it is still sent as JSON
+	var exporter taskExporter
+	start := func() {
+		var contentType string
+		exporter, contentType = newTaskExporter(exportParam.Format, ctx.Writer)
+		ctx.Header("Content-Type", contentType)
+		ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="tasks-%s.%s"`, time.Now().Format("20060102"), exportParam.Format))
+		ctx.Status(http.StatusOK)
+	}
+
+	err := r.uc.Task.Export(ctx.Request.Context(), param, func(task entity.Task, category string) error {
+		if exporter == nil {
+			start()
+		}
+		return exporter.Write(task, category)
+	})
+	if err != nil && exporter == nil {
+		r.httpRespError(ctx, err)
+		return
+	} else if err != nil {
+		// The client receives a truncated file
+		r.log.Error(ctx.Request.Context(), err)
+		return
+	}
+
+	if exporter == nil {
+		start()
+	}
+
+	if err := exporter.Close(); err != nil {
+		r.log.Error(ctx.Request.Context(), err)
+	}
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/gg-project/utils/ics"
+)
+
+// taskExporter write the exported tasks in one file format
+type taskExporter interface {
+	Write(task entity.Task, category string) error
+	Close() error
+}
+
+// taskToFileItem convert the task into the item of the CSV and JSON export
+func taskToFileItem(task entity.Task, category string) entity.TaskFileItem {
+	return entity.TaskFileItem{
+		Title:       task.Title,
+		Description: task.Description,
+		Category:    category,
+		Priority:    task.Priority,
+		TaskStatus:  task.TaskStatus,
+		Periodic:    task.Periodic,
+		RRule:       task.RRule,
+		DueTime:     task.DueTime,
+	}
+}
+
+// newTaskExporter return the exporter of the format and the content type of the file
+func newTaskExporter(format string, w io.Writer) (taskExporter, string) {
+	switch format {
+	case entity.TaskFileFormatCSV:
+		return &csvTaskExporter{w: csv.NewWriter(w)}, "text/csv; charset=utf-8"
+	case entity.TaskFileFormatICS:
+		return &icsTaskExporter{w: ics.NewWriter(w, icsProdID, "Tasks")}, ics.ContentType
+	default:
+		return &jsonTaskExporter{w: w}, "application/json; charset=utf-8"
+	}
+}
+
+type csvTaskExporter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (e *csvTaskExporter) Write(task entity.Task, category string) error {
+	if !e.header {
+		e.header = true
+		if err := e.w.Write(entity.TaskFileCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	item := taskToFileItem(task, category)
+
+	dueTime := ""
+	if item.DueTime.Valid {
+		dueTime = item.DueTime.Time.Format(time.RFC3339)
+	}
+
+	return e.w.Write([]string{
+		item.Title,
+		item.Description,
+		item.Category,
+		strconv.FormatInt(item.Priority, 10),
+		item.TaskStatus,
+		item.Periodic,
+		item.RRule,
+		dueTime,
+	})
+}
+
+func (e *csvTaskExporter) Close() error {
+	// The header is still written when there is no task
+	if !e.header {
+		e.header = true
+		if err := e.w.Write(entity.TaskFileCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonTaskExporter write a JSON array of the items, each item is encoded as soon as it is read
+type jsonTaskExporter struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonTaskExporter) Write(task entity.Task, category string) error {
+	item := taskToFileItem(task, category)
+
+	// null.Time only implements the JSON marshaler on its pointer
+	blob, err := json.Marshal(&item)
+	if err != nil {
+		return err
+	}
+
+	separator := ","
+	if e.count == 0 {
+		separator = "["
+	}
+	e.count++
+
+	_, err = e.w.Write(append([]byte(separator), blob...))
+	return err
+}
+
+func (e *jsonTaskExporter) Close() error {
+	end := "]"
+	if e.count == 0 {
+		end = "[]"
+	}
+
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+type icsTaskExporter struct {
+	w *ics.Writer
+}
+
+func (e *icsTaskExporter) Write(task entity.Task, category string) error {
+	// Task without due time has zero time and is written without DUE
+	c := taskToICS(task, task.DueTime.Time, false, ics.ComponentTodo)
+
+	if category != "" {
+		// The first category is read back as the category of the task by the import
+		c.Categories = append([]string{category}, c.Categories...)
+	}
+
+	return e.w.Write(c)
+}
+
+func (e *icsTaskExporter) Close() error {
+	return e.w.Close()
+}
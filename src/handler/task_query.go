@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/gin-gonic/gin"
+)
+
+// taskSortFields map the sortable field of the task list to its param tag
+var taskSortFields = map[string]string{
+	"id":         "id",
+	"title":      "title",
+	"priority":   "priority",
+	"taskStatus": "task_status",
+	"dueTime":    "due_time",
+	"position":   "position",
+	"createdAt":  "created_at",
+	"updatedAt":  "updated_at",
+}
+
+// bindTaskListParam bind the task list params and validate the filters and sorting from the query string
+func (r *rest) bindTaskListParam(ctx *gin.Context, param *entity.TaskParam) error {
+	if err := r.BindParams(ctx, param); err != nil {
+		return err
+	}
+
+	var listQuery entity.TaskListQuery
+	if err := r.BindQuery(ctx, &listQuery); err != nil {
+		return err
+	}
+
+	for _, status := range splitQueryValues(listQuery.TaskStatus) {
+		switch status {
+		case entity.TaskStatusTodo, entity.TaskStatusOnGoing, entity.TaskStatusDone:
+			param.TaskStatuses = append(param.TaskStatuses, status)
+		default:
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid taskStatus %q, must be one of todo, ongoing, done", status))
+		}
+	}
+
+	for _, value := range splitQueryValues(listQuery.CategoryID) {
+		categoryID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid categoryId %q", value))
+		}
+		param.CategoryIDs = append(param.CategoryIDs, categoryID)
+	}
+
+	if listQuery.PriorityMin != "" {
+		priorityMin, err := strconv.ParseInt(listQuery.PriorityMin, 10, 64)
+		if err != nil {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid priorityMin %q", listQuery.PriorityMin))
+		}
+		param.PriorityGTE = null.Int64From(priorityMin)
+	}
+
+	if listQuery.DueFrom != "" {
+		dueFrom, _, err := parseQueryTime(listQuery.DueFrom)
+		if err != nil {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid dueFrom %q, must be a date (2006-01-02) or RFC 3339 time", listQuery.DueFrom))
+		}
+		param.DueTimeGTE = null.TimeFrom(dueFrom)
+	}
+
+	if listQuery.DueTo != "" {
+		dueTo, isDate, err := parseQueryTime(listQuery.DueTo)
+		if err != nil {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid dueTo %q, must be a date (2006-01-02) or RFC 3339 time", listQuery.DueTo))
+		}
+
+		// The whole day is included when only the date is given
+		if isDate {
+			param.DueTimeLT = null.TimeFrom(dueTo.AddDate(0, 0, 1))
+		} else {
+			param.DueTimeLTE = null.TimeFrom(dueTo)
+		}
+
+		if param.DueTimeGTE.Valid && dueTo.Before(param.DueTimeGTE.Time) {
+			return errors.NewWithCode(codes.CodeBadRequest, "dueTo must be after dueFrom")
+		}
+	}
+
+	param.Overdue = listQuery.Overdue
+	param.AssignedToMe = listQuery.AssignedToMe
+
+	sortBy := []string{}
+	for _, field := range splitQueryValues(listQuery.SortBy) {
+		direction := ""
+		if strings.HasPrefix(field, "-") {
+			direction, field = "-", strings.TrimPrefix(field, "-")
+		}
+
+		paramTag, ok := taskSortFields[field]
+		if !ok {
+			return errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid sortBy %q, must be one of %s", field, strings.Join(sortableTaskFields(), ", ")))
+		}
+		sortBy = append(sortBy, direction+paramTag)
+	}
+	param.SortBy = sortBy
+
+	return nil
+}
+
+// splitQueryValues flatten the repeated and comma separated query values
+func splitQueryValues(values []string) []string {
+	result := []string{}
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+
+	return result
+}
+
+// parseQueryTime parse a date or RFC 3339 time, true means only the date is given
+func parseQueryTime(value string) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation(entity.ConstLayoutDateFormat, value, time.Local); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+
+	return t, false, err
+}
+
+// parseQueryRange parse the time zone and the date range of a report, the dates are read in the time zone
+// and the whole day of the to date is included. Empty value is returned as zero time
+func parseQueryRange(timezone, fromValue, toValue string) (*time.Location, time.Time, time.Time, error) {
+	var from, to time.Time
+
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, from, to, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid timezone %q", timezone))
+		}
+	}
+
+	if fromValue != "" {
+		var err error
+		if from, err = time.ParseInLocation(entity.ConstLayoutDateFormat, fromValue, loc); err != nil {
+			if from, err = time.Parse(time.RFC3339, fromValue); err != nil {
+				return nil, from, to, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid from %q, must be a date (2006-01-02) or RFC 3339 time", fromValue))
+			}
+		}
+	}
+
+	if toValue != "" {
+		var err error
+		if to, err = time.ParseInLocation(entity.ConstLayoutDateFormat, toValue, loc); err == nil {
+			to = to.AddDate(0, 0, 1)
+		} else if to, err = time.Parse(time.RFC3339, toValue); err != nil {
+			return nil, from, to, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid to %q, must be a date (2006-01-02) or RFC 3339 time", toValue))
+		}
+	}
+
+	return loc, from, to, nil
+}
+
+func sortableTaskFields() []string {
+	fields := []string{}
+	for field := range taskSortFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields
+}
@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/adiatma85/own-go-sdk/errors"
+	"github.com/adiatma85/own-go-sdk/null"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Start Task Timer
+// @Description Start tracking the time spent on the Task, the user can only have one running timer
+// @Security BearerAuth
+// @Tags Time Entry
+// @Param task_id path integer true "task id"
+// @Param data body entity.StartTimerParam false "Timer Note"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TimeEntry{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/timer/start [POST]
+func (r *rest) StartTaskTimer(ctx *gin.Context) {
+	// The note is optional, so is the body
+	var param entity.StartTimerParam
+	if ctx.Request.ContentLength > 0 {
+		if err := r.Bind(ctx, &param); err != nil {
+			r.httpRespError(ctx, err)
+			return
+		}
+	}
+
+	var taskParam entity.TaskParam
+	if err := r.BindUri(ctx, &taskParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	timeEntry, err := r.uc.TimeEntry.Start(ctx.Request.Context(), taskParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, timeEntry, nil)
+}
+
+// @Summary Stop Task Timer
+// @Description Stop the running timer on the Task and record the tracked time
+// @Security BearerAuth
+// @Tags Time Entry
+// @Param task_id path integer true "task id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TimeEntry{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/timer/stop [POST]
+func (r *rest) StopTaskTimer(ctx *gin.Context) {
+	var taskParam entity.TaskParam
+	if err := r.BindUri(ctx, &taskParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	timeEntry, err := r.uc.TimeEntry.Stop(ctx.Request.Context(), taskParam)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, timeEntry, nil)
+}
+
+// @Summary Get Running Timer
+// @Description Get the running timer of the user
+// @Security BearerAuth
+// @Tags Time Entry
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TimeEntry{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/timer [GET]
+func (r *rest) GetRunningTimer(ctx *gin.Context) {
+	timeEntry, err := r.uc.TimeEntry.GetRunning(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, timeEntry, nil)
+}
+
+// @Summary Create Task Time Entry
+// @Description Add the time which was spent on the Task without the timer, the time must not overlap the other entries of the user
+// @Security BearerAuth
+// @Tags Time Entry
+// @Param task_id path integer true "task id"
+// @Param data body entity.CreateTimeEntryParam true "Input New Time Entry Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.TimeEntry{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/time-entries [POST]
+func (r *rest) CreateTaskTimeEntry(ctx *gin.Context) {
+	var param entity.CreateTimeEntryParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var taskParam entity.TaskParam
+	if err := r.BindUri(ctx, &taskParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	timeEntry, err := r.uc.TimeEntry.Create(ctx.Request.Context(), taskParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, timeEntry, nil)
+}
+
+// @Summary Get Task Time Entry List
+// @Description Get list of time entries on the Task, latest first
+// @Security BearerAuth
+// @Tags Time Entry
+// @Param task_id path integer true "task id"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.TimeEntry{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/time-entries [GET]
+func (r *rest) GetListTaskTimeEntry(ctx *gin.Context) {
+	var param entity.TimeEntryParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	timeEntries, pg, err := r.uc.TimeEntry.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, timeEntries, pg)
+}
+
+// @Summary Delete Task Time Entry
+// @Description Delete the time entry of the Task, only the user who tracked it and the managers of the Task can delete it
+// @Security BearerAuth
+// @Tags Time Entry
+// @Param task_id path integer true "task id"
+// @Param time_entry_id path integer true "time entry id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/task/{task_id}/time-entries/{time_entry_id} [DELETE]
+func (r *rest) DeleteTaskTimeEntry(ctx *gin.Context) {
+	var param entity.TimeEntryParam
+	if err := r.BindUri(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.TimeEntry.Delete(ctx.Request.Context(), param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Get Time Entry Total
+// @Description Get the time spent by the user per task, category or day, the running timer is counted until now
+// @Security BearerAuth
+// @Tags Time Entry
+// @Param groupBy query string false "group of the totals, default is task" Enums(task, category, day)
+// @Param taskId query integer false "only the time entries of the task"
+// @Param from query string false "date (2006-01-02) or RFC 3339 time, default is 30 days before to"
+// @Param to query string false "date (2006-01-02) or RFC 3339 time, the whole day is included when only the date is given, default is now"
+// @Param timezone query string false "IANA time zone of the dates and the day group, e.g. Asia/Jakarta, default is UTC"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.TimeEntryTotal{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/time-entry/total [GET]
+func (r *rest) GetTimeEntryTotal(ctx *gin.Context) {
+	var totalQuery entity.TimeEntryTotalQuery
+	if err := r.BindQuery(ctx, &totalQuery); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	param, err := parseTimeEntryTotalQuery(totalQuery)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	totals, err := r.uc.TimeEntry.GetTotal(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, totals, nil)
+}
+
+// parseTimeEntryTotalQuery convert the query string into the total param, the dates are read in the time zone
+func parseTimeEntryTotalQuery(totalQuery entity.TimeEntryTotalQuery) (entity.TimeEntryTotalParam, error) {
+	param := entity.TimeEntryTotalParam{GroupBy: totalQuery.GroupBy}
+
+	if totalQuery.TaskID != "" {
+		taskID, err := strconv.ParseInt(totalQuery.TaskID, 10, 64)
+		if err != nil {
+			return param, errors.NewWithCode(codes.CodeBadRequest, fmt.Sprintf("invalid taskId %q", totalQuery.TaskID))
+		}
+		param.TaskID = null.Int64From(taskID)
+	}
+
+	var err error
+	param.Location, param.From, param.To, err = parseQueryRange(totalQuery.Timezone, totalQuery.From, totalQuery.To)
+
+	return param, err
+}
@@ -133,6 +133,24 @@ func (r *rest) UserProfile(ctx *gin.Context) {
 	r.httpRespSuccess(ctx, codes.CodeSuccess, userProfile, nil)
 }
 
+// @Summary Get User Self Progress
+// @Description Get the XP, level and completion streaks of the user, XP is awarded when a task is done
+// @Security BearerAuth
+// @Tags User
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.UserProgress{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/user/profile/progress [GET]
+func (r *rest) UserProgress(ctx *gin.Context) {
+	progress, err := r.uc.Progress.GetSelf(ctx.Request.Context())
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, progress, nil)
+}
+
 // @Summary Self Delete for User
 // @Description Self Delete for User
 // @Security BearerAuth
@@ -0,0 +1,463 @@
+package handler
+
+import (
+	"github.com/adiatma85/gg-project/src/business/entity"
+	"github.com/adiatma85/own-go-sdk/codes"
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary Create Workspace
+// @Description Create new Workspace, the user becomes its owner
+// @Security BearerAuth
+// @Tags Workspace
+// @Param data body entity.CreateWorkspaceParam true "Input New Workspace Data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Workspace{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace [POST]
+func (r *rest) CreateWorkspace(ctx *gin.Context) {
+	var param entity.CreateWorkspaceParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	workspace, err := r.uc.Workspace.Create(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, workspace, nil)
+}
+
+// @Summary Get Workspace List
+// @Description Get the Workspaces the user is a member of with the role of the user
+// @Security BearerAuth
+// @Tags Workspace
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.Workspace{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace [GET]
+func (r *rest) GetListWorkspace(ctx *gin.Context) {
+	var param entity.WorkspaceParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	workspaces, pg, err := r.uc.Workspace.GetList(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, workspaces, pg)
+}
+
+// @Summary Get Workspace By ID
+// @Description Get Workspace details by Workspace ID, only the members can see the Workspace
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Workspace{}}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id} [GET]
+func (r *rest) GetWorkspaceByID(ctx *gin.Context) {
+	var param entity.WorkspaceParam
+	if err := r.BindUri(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	workspace, err := r.uc.Workspace.Get(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, workspace, nil)
+}
+
+// @Summary Update Workspace
+// @Description Update the name and the description of the Workspace, only the owner and the admins can update it
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Param data body entity.UpdateWorkspaceParam true "workspace data"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id} [PUT]
+func (r *rest) UpdateWorkspace(ctx *gin.Context) {
+	var updateParam entity.UpdateWorkspaceParam
+	if err := r.Bind(ctx, &updateParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.WorkspaceParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Workspace.Update(ctx.Request.Context(), updateParam, selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Delete Workspace
+// @Description Soft delete the Workspace with its members, only the owner can delete it
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id} [DELETE]
+func (r *rest) DeleteWorkspace(ctx *gin.Context) {
+	var selectParam entity.WorkspaceParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Workspace.Delete(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Transfer Workspace Ownership
+// @Description Make another member the owner of the Workspace, the old owner stays as an admin
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Param data body entity.TransferWorkspaceParam true "New Owner"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.Workspace{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id}/transfer [POST]
+func (r *rest) TransferWorkspace(ctx *gin.Context) {
+	var param entity.TransferWorkspaceParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.WorkspaceParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	workspace, err := r.uc.Workspace.TransferOwnership(ctx.Request.Context(), selectParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, workspace, nil)
+}
+
+// @Summary Leave Workspace
+// @Description Leave the Workspace, the owner has to transfer the ownership before leaving
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id}/leave [POST]
+func (r *rest) LeaveWorkspace(ctx *gin.Context) {
+	var selectParam entity.WorkspaceParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Workspace.Leave(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Get Workspace Member List
+// @Description Get the members of the Workspace with their roles, only the members can see them
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.WorkspaceMember{}}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id}/members [GET]
+func (r *rest) GetListWorkspaceMember(ctx *gin.Context) {
+	var param entity.WorkspaceMemberParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	members, pg, err := r.uc.Workspace.GetMembers(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, members, pg)
+}
+
+// @Summary Update Workspace Member
+// @Description Change the role of the member, only the owner can change the role of an admin
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Param user_id path integer true "user id"
+// @Param data body entity.UpdateWorkspaceMemberParam true "member role"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.WorkspaceMember{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id}/members/{user_id} [PUT]
+func (r *rest) UpdateWorkspaceMember(ctx *gin.Context) {
+	var updateParam entity.UpdateWorkspaceMemberParam
+	if err := r.Bind(ctx, &updateParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.WorkspaceMemberParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	member, err := r.uc.Workspace.UpdateMember(ctx.Request.Context(), updateParam, selectParam)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, member, nil)
+}
+
+// @Summary Remove Workspace Member
+// @Description Remove the member from the Workspace, the owner cannot be removed
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Param user_id path integer true "user id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id}/members/{user_id} [DELETE]
+func (r *rest) DeleteWorkspaceMember(ctx *gin.Context) {
+	var selectParam entity.WorkspaceMemberParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Workspace.RemoveMember(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Invite Workspace Member
+// @Description Invite the user by email to join the Workspace, only the owner and the admins can invite
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Param data body entity.CreateWorkspaceInvitationParam true "Invitation"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.WorkspaceInvitation{}}
+// @Failure 400 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 409 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id}/invitations [POST]
+func (r *rest) CreateWorkspaceInvitation(ctx *gin.Context) {
+	var param entity.CreateWorkspaceInvitationParam
+	if err := r.Bind(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	var selectParam entity.WorkspaceParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	invitation, err := r.uc.Workspace.Invite(ctx.Request.Context(), selectParam, param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, invitation, nil)
+}
+
+// @Summary Get Workspace Invitation List
+// @Description Get the invitations of the Workspace, only the owner and the admins can see them
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Param invitationStatus query string false "invitation status" Enums(pending, accepted, declined, revoked)
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.WorkspaceInvitation{}}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id}/invitations [GET]
+func (r *rest) GetListWorkspaceInvitation(ctx *gin.Context) {
+	var param entity.WorkspaceInvitationParam
+	if err := r.BindParams(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	invitations, pg, err := r.uc.Workspace.GetInvitations(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, invitations, pg)
+}
+
+// @Summary Revoke Workspace Invitation
+// @Description Revoke the pending invitation, only the owner and the admins can revoke it
+// @Security BearerAuth
+// @Tags Workspace
+// @Param workspace_id path integer true "workspace id"
+// @Param invitation_id path integer true "invitation id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 403 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/{workspace_id}/invitations/{invitation_id} [DELETE]
+func (r *rest) DeleteWorkspaceInvitation(ctx *gin.Context) {
+	var selectParam entity.WorkspaceInvitationParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Workspace.RevokeInvitation(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
+
+// @Summary Get My Workspace Invitations
+// @Description Get the pending invitations sent to the email of the user
+// @Security BearerAuth
+// @Tags Workspace
+// @Param limit query integer false "limit"
+// @Param page query integer false "page"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=[]entity.WorkspaceInvitation{}}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/invitations [GET]
+func (r *rest) GetMyWorkspaceInvitations(ctx *gin.Context) {
+	var param entity.WorkspaceInvitationParam
+	if err := r.BindQuery(ctx, &param); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	invitations, pg, err := r.uc.Workspace.GetMyInvitations(ctx.Request.Context(), param)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, invitations, pg)
+}
+
+// @Summary Accept Workspace Invitation
+// @Description Accept the pending invitation sent to the email of the user and join the Workspace
+// @Security BearerAuth
+// @Tags Workspace
+// @Param invitation_id path integer true "invitation id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{data=entity.WorkspaceMember{}}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/invitations/{invitation_id}/accept [POST]
+func (r *rest) AcceptWorkspaceInvitation(ctx *gin.Context) {
+	var selectParam entity.WorkspaceInvitationParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	member, err := r.uc.Workspace.AcceptInvitation(ctx.Request.Context(), selectParam)
+	if err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, member, nil)
+}
+
+// @Summary Decline Workspace Invitation
+// @Description Decline the pending invitation sent to the email of the user
+// @Security BearerAuth
+// @Tags Workspace
+// @Param invitation_id path integer true "invitation id"
+// @Produce json
+// @Success 200 {object} entity.HTTPResp{}
+// @Failure 404 {object} entity.HTTPResp{}
+// @Failure 500 {object} entity.HTTPResp{}
+// @Router /v1/workspace/invitations/{invitation_id}/decline [POST]
+func (r *rest) DeclineWorkspaceInvitation(ctx *gin.Context) {
+	var selectParam entity.WorkspaceInvitationParam
+	if err := r.BindUri(ctx, &selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	if err := r.uc.Workspace.DeclineInvitation(ctx.Request.Context(), selectParam); err != nil {
+		r.httpRespError(ctx, err)
+		return
+	}
+
+	r.httpRespSuccess(ctx, codes.CodeSuccess, nil, nil)
+}
@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/adiatma85/gg-project/src/business/usecase"
+	"github.com/adiatma85/own-go-sdk/log"
+)
+
+type Interface interface {
+	Run()
+}
+
+type Config struct {
+	Reminder JobConfig
+}
+
+type JobConfig struct {
+	Enabled  bool
+	Interval time.Duration
+}
+
+type InitParam struct {
+	Conf Config
+	Log  log.Interface
+	Uc   *usecase.Usecase
+}
+
+type scheduler struct {
+	conf Config
+	log  log.Interface
+	uc   *usecase.Usecase
+}
+
+func Init(param InitParam) Interface {
+	return &scheduler{
+		conf: param.Conf,
+		log:  param.Log,
+		uc:   param.Uc,
+	}
+}
+
+// Run start the enabled jobs and block until the process is interrupted
+func (s *scheduler) Run() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	wg := &sync.WaitGroup{}
+	s.start(ctx, wg, "reminder", s.conf.Reminder, s.dispatchReminder)
+
+	wg.Wait()
+	s.log.Info(context.Background(), "Scheduler Shut Down.")
+}
+
+// start run the job every interval in its own goroutine, the next run waits for the previous one to finish
+func (s *scheduler) start(ctx context.Context, wg *sync.WaitGroup, name string, conf JobConfig, job func(ctx context.Context)) {
+	if !conf.Enabled {
+		return
+	}
+
+	interval := conf.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	s.log.Info(ctx, fmt.Sprintf("Scheduling %s job every %s", name, interval))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			job(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *scheduler) dispatchReminder(ctx context.Context) {
+	sent, err := s.uc.Reminder.Dispatch(ctx)
+	if err != nil {
+		s.log.Error(ctx, fmt.Sprintf("reminder dispatch error: %v", err))
+		return
+	}
+
+	if sent > 0 {
+		s.log.Info(ctx, fmt.Sprintf("sent %d reminder(s)", sent))
+	}
+}
@@ -3,6 +3,10 @@ package config
 import (
 	"time"
 
+	"github.com/adiatma85/gg-project/src/business/domain/notifier"
+	"github.com/adiatma85/gg-project/src/business/domain/storage"
+	"github.com/adiatma85/gg-project/src/business/usecase"
+	"github.com/adiatma85/gg-project/src/scheduler"
 	"github.com/adiatma85/own-go-sdk/instrument"
 	"github.com/adiatma85/own-go-sdk/jwtAuth"
 	"github.com/adiatma85/own-go-sdk/log"
@@ -20,6 +24,10 @@ type Application struct {
 	Instrument instrument.Config
 	Redis      redis.Config
 	JwtAuth    jwtAuth.Config
+	Usecase    usecase.Config
+	Storage    storage.Config
+	Notifier   notifier.Config
+	Scheduler  scheduler.Config
 }
 
 type ApplicationMeta struct {
@@ -0,0 +1,163 @@
+// Package ics read and write iCalendar (RFC 5545) data
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	ComponentTodo  = "VTODO"
+	ComponentEvent = "VEVENT"
+
+	// Status of VTODO
+	StatusNeedsAction = "NEEDS-ACTION"
+	StatusInProcess   = "IN-PROCESS"
+	StatusCompleted   = "COMPLETED"
+
+	ContentType = "text/calendar; charset=utf-8"
+
+	dateTimeFormat = "20060102T150405Z"
+
+	// Content line longer than this is folded
+	maxLineOctets = 75
+)
+
+type Component struct {
+	Kind        string // VTODO or VEVENT
+	UID         string
+	Stamp       time.Time // Last modification time of the data
+	Summary     string
+	Description string
+	Categories  []string
+	Due         time.Time // DUE of VTODO or DTSTART of VEVENT, todo without due time leaves it zero
+	Status      string    // Only written on VTODO
+	RRule       string
+}
+
+// Writer stream the components into one VCALENDAR, Close must be called to finish the calendar
+type Writer struct {
+	w   *bufio.Writer
+	err error
+}
+
+// NewWriter write the calendar header, name is shown by the calendar apps as the name of the feed
+func NewWriter(w io.Writer, prodID, name string) *Writer {
+	cw := &Writer{w: bufio.NewWriter(w)}
+
+	cw.line("BEGIN", "VCALENDAR")
+	cw.line("VERSION", "2.0")
+	cw.line("PRODID", prodID)
+	cw.line("CALSCALE", "GREGORIAN")
+	cw.line("METHOD", "PUBLISH")
+	if name != "" {
+		cw.line("X-WR-CALNAME", Escape(name))
+	}
+
+	return cw
+}
+
+func (cw *Writer) Write(c Component) error {
+	kind := c.Kind
+	if kind != ComponentEvent {
+		kind = ComponentTodo
+	}
+
+	cw.line("BEGIN", kind)
+	cw.line("UID", Escape(c.UID))
+	cw.line("DTSTAMP", FormatTime(c.Stamp))
+	cw.line("SUMMARY", Escape(c.Summary))
+
+	if c.Description != "" {
+		cw.line("DESCRIPTION", Escape(c.Description))
+	}
+
+	if len(c.Categories) > 0 {
+		categories := make([]string, len(c.Categories))
+		for i, category := range c.Categories {
+			categories[i] = Escape(category)
+		}
+		cw.line("CATEGORIES", strings.Join(categories, ","))
+	}
+
+	if kind == ComponentEvent {
+		// Event without DTEND ends at the same time it starts
+		cw.line("DTSTART", FormatTime(c.Due))
+	} else {
+		if !c.Due.IsZero() {
+			cw.line("DUE", FormatTime(c.Due))
+		}
+		if c.Status != "" {
+			cw.line("STATUS", c.Status)
+		}
+	}
+
+	if c.RRule != "" {
+		cw.line("RRULE", c.RRule)
+	}
+
+	cw.line("END", kind)
+
+	return cw.err
+}
+
+// Close write the calendar footer and flush the buffered data
+func (cw *Writer) Close() error {
+	cw.line("END", "VCALENDAR")
+	if cw.err != nil {
+		return cw.err
+	}
+
+	return cw.w.Flush()
+}
+
+// line write one content line, folded into 75 octets and terminated with CRLF
+func (cw *Writer) line(name, value string) {
+	if cw.err != nil {
+		return
+	}
+
+	content := name + ":" + value
+	limit := maxLineOctets
+	for len(content) > limit {
+		// Do not split a multi-byte UTF-8 character
+		cut := limit
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+
+		if _, cw.err = cw.w.WriteString(content[:cut] + "\r\n "); cw.err != nil {
+			return
+		}
+
+		content = content[cut:]
+		// The leading space of the continuation line counts toward the limit
+		limit = maxLineOctets - 1
+	}
+
+	_, cw.err = cw.w.WriteString(content + "\r\n")
+}
+
+// Escape escape the TEXT value
+func Escape(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, ";", "\\;")
+	s = strings.ReplaceAll(s, ",", "\\,")
+	s = strings.ReplaceAll(s, "\r\n", "\\n")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+
+	return s
+}
+
+// FormatTime format the time as UTC DATE-TIME
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
+
+// UID return a globally unique identifier of the data, e.g. task-1@gg-project
+func UID(kind string, id interface{}, domain string) string {
+	return fmt.Sprintf("%s-%v@%s", kind, id, domain)
+}
@@ -0,0 +1,225 @@
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	floatingFormat = "20060102T150405"
+	dateFormat     = "20060102"
+)
+
+// Maximum length of one unfolded content line
+const maxLineLength = 64 * 1024
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse read the VTODO and VEVENT components of the calendar, the other components are skipped
+func Parse(r io.Reader) ([]Component, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Component{}
+	var current *Component
+	depth := 0 // Nesting inside the current component, e.g. VALARM
+
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		p, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+
+		switch {
+		case p.name == "BEGIN" && current == nil && (p.value == ComponentTodo || p.value == ComponentEvent):
+			current = &Component{Kind: p.value}
+		case p.name == "BEGIN" && current != nil:
+			depth++
+		case p.name == "END" && current != nil && depth > 0:
+			depth--
+		case p.name == "END" && current != nil:
+			result = append(result, *current)
+			current = nil
+		case current != nil && depth == 0:
+			if err := current.set(p); err != nil {
+				return nil, fmt.Errorf("line %d: %v", i+1, err)
+			}
+		}
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("%s is not closed", current.Kind)
+	}
+
+	return result, nil
+}
+
+func (c *Component) set(p property) error {
+	var err error
+
+	switch p.name {
+	case "UID":
+		c.UID = p.value
+	case "SUMMARY":
+		c.Summary = Unescape(p.value)
+	case "DESCRIPTION":
+		c.Description = Unescape(p.value)
+	case "CATEGORIES":
+		for _, category := range splitText(p.value) {
+			if category = strings.TrimSpace(category); category != "" {
+				c.Categories = append(c.Categories, category)
+			}
+		}
+	case "STATUS":
+		c.Status = strings.ToUpper(p.value)
+	case "RRULE":
+		c.RRule = p.value
+	case "DTSTAMP", "LAST-MODIFIED":
+		c.Stamp, err = ParseTime(p.value, p.params["TZID"])
+	case "DUE":
+		c.Due, err = ParseTime(p.value, p.params["TZID"])
+	case "DTSTART":
+		// DTSTART is the due time of an event, todo only use it when it has no DUE
+		if c.Kind == ComponentEvent || c.Due.IsZero() {
+			c.Due, err = ParseTime(p.value, p.params["TZID"])
+		}
+	}
+
+	return err
+}
+
+// unfold join the folded content lines and strip the line endings
+func unfold(r io.Reader) ([]string, error) {
+	lines := []string{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineLength)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// parseProperty split the content line into its name, parameters and value, e.g. DUE;TZID=Asia/Jakarta:20260101T090000
+func parseProperty(line string) (property, error) {
+	p := property{params: map[string]string{}}
+
+	// The value starts at the first colon which is not inside a quoted parameter value
+	quoted := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		} else if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+
+	if colon < 0 {
+		return p, fmt.Errorf("invalid content line %q", line)
+	}
+
+	p.value = line[colon+1:]
+
+	parts := strings.Split(line[:colon], ";")
+	p.name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) == 2 {
+			p.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+
+	return p, nil
+}
+
+// ParseTime parse DATE or DATE-TIME value, floating time is read in the TZID location or UTC when it is unknown
+func ParseTime(value, tzid string) (time.Time, error) {
+	loc := time.UTC
+	if tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(value, "Z"):
+		return time.Parse(dateTimeFormat, value)
+	case len(value) == len(dateFormat):
+		return time.ParseInLocation(dateFormat, value, loc)
+	default:
+		return time.ParseInLocation(floatingFormat, value, loc)
+	}
+}
+
+// Unescape revert Escape of the TEXT value
+func Unescape(s string) string {
+	var b strings.Builder
+
+	escaped := false
+	for _, r := range s {
+		if !escaped {
+			if r == '\\' {
+				escaped = true
+			} else {
+				b.WriteRune(r)
+			}
+			continue
+		}
+
+		escaped = false
+		switch r {
+		case 'n', 'N':
+			b.WriteRune('\n')
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// splitText split the comma separated TEXT list, escaped comma is kept in the value
+func splitText(s string) []string {
+	result := []string{}
+
+	start := 0
+	escaped := false
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			result = append(result, Unescape(s[start:i]))
+			start = i + 1
+		}
+	}
+
+	return append(result, Unescape(s[start:]))
+}
@@ -0,0 +1,296 @@
+// Package rank generate the lexicographic rank keys of the fractional indexing. A key can always be generated
+// between two keys, so moving an item only changes the key of that item.
+//
+// A key is an integer part followed by an optional fraction. The head of the integer part tells its length, so the
+// keys appended at the end of a list grow by incrementing the integer part instead of getting longer.
+package rank
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// Digits of the key in their sort order, the keys are compared byte by byte
+	digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	// First is the key of the first item of an empty list
+	First = "a0"
+
+	// MaxLength is the longest valid key, the length of the column the key is stored in
+	MaxLength = 255
+
+	// RebalanceLength is the length from which the list should be rebalanced with Sequence before its keys reach
+	// MaxLength, repeatedly inserting into the same gap makes the key one character longer every few inserts
+	RebalanceLength = 128
+
+	// Smallest integer part, nothing can be generated before it without a fraction
+	smallestInteger = "A00000000000000000000000000"
+)
+
+// Between return a key which sorts after a and before b. Empty a is the beginning and empty b is the end of the list
+func Between(a, b string) (string, error) {
+	if a != "" {
+		if err := Validate(a); err != nil {
+			return "", err
+		}
+	}
+
+	if b != "" {
+		if err := Validate(b); err != nil {
+			return "", err
+		}
+	}
+
+	if a != "" && b != "" && a >= b {
+		return "", fmt.Errorf("rank key %q is not before %q", a, b)
+	}
+
+	if a == "" {
+		if b == "" {
+			return First, nil
+		}
+
+		ib := integerPart(b)
+		if ib == smallestInteger {
+			return checkLength(ib + midpoint("", b[len(ib):]))
+		}
+
+		if ib < b {
+			return ib, nil
+		}
+
+		key, ok := decrementInteger(ib)
+		if !ok {
+			return "", fmt.Errorf("no rank key before %q", b)
+		}
+
+		return key, nil
+	}
+
+	ia := integerPart(a)
+	fa := a[len(ia):]
+
+	if b == "" {
+		if key, ok := incrementInteger(ia); ok {
+			return key, nil
+		}
+
+		return checkLength(ia + midpoint(fa, ""))
+	}
+
+	ib := integerPart(b)
+	if ia == ib {
+		return checkLength(ia + midpoint(fa, b[len(ib):]))
+	}
+
+	key, ok := incrementInteger(ia)
+	if !ok {
+		return "", fmt.Errorf("no rank key after %q", a)
+	}
+
+	if key < b {
+		return key, nil
+	}
+
+	return checkLength(ia + midpoint(fa, ""))
+}
+
+// Sequence return n short keys in ascending order, it is used to rebalance a list whose keys became too long
+func Sequence(n int) ([]string, error) {
+	keys := make([]string, 0, n)
+
+	key := First
+	for i := 0; i < n; i++ {
+		keys = append(keys, key)
+
+		next, ok := incrementInteger(key)
+		if !ok {
+			return nil, fmt.Errorf("no rank key after %q", key)
+		}
+		key = next
+	}
+
+	return keys, nil
+}
+
+// Validate return an error when the key is not a valid rank key
+func Validate(key string) error {
+	if key == "" {
+		return fmt.Errorf("rank key is empty")
+	}
+
+	if len(key) > MaxLength {
+		return fmt.Errorf("invalid rank key, it is longer than %d characters", MaxLength)
+	}
+
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return fmt.Errorf("invalid rank key %q, it has an invalid character %q", key, key[i])
+		}
+	}
+
+	length := integerLength(key[0])
+	if length < 0 || length > len(key) {
+		return fmt.Errorf("invalid rank key %q, its integer part is invalid", key)
+	}
+
+	if key == smallestInteger {
+		return fmt.Errorf("invalid rank key %q, it is the smallest integer", key)
+	}
+
+	if len(key) > length && key[len(key)-1] == digits[0] {
+		return fmt.Errorf("invalid rank key %q, its fraction ends with zero", key)
+	}
+
+	return nil
+}
+
+// checkLength return an error when the generated key is longer than MaxLength, so it is never truncated by the column
+func checkLength(key string) (string, error) {
+	if len(key) > MaxLength {
+		return "", fmt.Errorf("rank key is longer than %d characters, the list has to be rebalanced", MaxLength)
+	}
+
+	return key, nil
+}
+
+// midpoint return the fraction between the fractions a and b, empty b is the end. The fractions do not end with zero
+func midpoint(a, b string) string {
+	if b != "" {
+		// Keep the common prefix and find the midpoint of the rest
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(digits, a[0])
+	}
+
+	digitB := len(digits)
+	if b != "" {
+		digitB = strings.IndexByte(digits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(digits[(digitA+digitB+1)/2])
+	}
+
+	// The first digits are consecutive, so the midpoint has to be longer
+	if len(b) > 1 {
+		return b[:1]
+	}
+
+	return string(digits[digitA]) + midpoint(suffix(a, 1), "")
+}
+
+// integerLength return the length of the integer part from its head, a to z are the positive integers and A to Z are
+// the negative ones. It returns -1 on an invalid head
+func integerLength(head byte) int {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2
+	case head >= 'A' && head <= 'Z':
+		return int('Z'-head) + 2
+	}
+
+	return -1
+}
+
+// integerPart return the integer part of the validated key
+func integerPart(key string) string {
+	return key[:integerLength(key[0])]
+}
+
+func incrementInteger(x string) (string, bool) {
+	head, digs := x[0], []byte(x[1:])
+
+	carry := true
+	for i := len(digs) - 1; carry && i >= 0; i-- {
+		d := strings.IndexByte(digits, digs[i]) + 1
+		if d == len(digits) {
+			digs[i] = digits[0]
+		} else {
+			digs[i] = digits[d]
+			carry = false
+		}
+	}
+
+	if !carry {
+		return string(head) + string(digs), true
+	}
+
+	switch head {
+	case 'Z':
+		return "a" + string(digits[0]), true
+	case 'z':
+		return "", false
+	}
+
+	head++
+	if head > 'a' {
+		digs = append(digs, digits[0])
+	} else {
+		digs = digs[:len(digs)-1]
+	}
+
+	return string(head) + string(digs), true
+}
+
+func decrementInteger(x string) (string, bool) {
+	head, digs := x[0], []byte(x[1:])
+
+	borrow := true
+	for i := len(digs) - 1; borrow && i >= 0; i-- {
+		d := strings.IndexByte(digits, digs[i]) - 1
+		if d < 0 {
+			digs[i] = digits[len(digits)-1]
+		} else {
+			digs[i] = digits[d]
+			borrow = false
+		}
+	}
+
+	if !borrow {
+		return string(head) + string(digs), true
+	}
+
+	switch head {
+	case 'a':
+		return "Z" + string(digits[len(digits)-1]), true
+	case 'A':
+		return "", false
+	}
+
+	head--
+	if head < 'Z' {
+		digs = append(digs, digits[len(digits)-1])
+	} else {
+		digs = digs[:len(digs)-1]
+	}
+
+	return string(head) + string(digs), true
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+
+	return digits[0]
+}
+
+func suffix(s string, i int) string {
+	if i < len(s) {
+		return s[i:]
+	}
+
+	return ""
+}
@@ -0,0 +1,176 @@
+package rank
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty list", a: "", b: "", want: "a0"},
+		{name: "before the first key", a: "", b: "a0", want: "Zz"},
+		{name: "before a negative key", a: "", b: "Zz", want: "Zy"},
+		{name: "before a key with fraction", a: "", b: "a0V", want: "a0"},
+		{name: "before the largest key of a length", a: "", b: "b999", want: "b99"},
+		{name: "before the smallest integer part", a: "", b: "Y00", want: "Xzzz"},
+		{name: "after the last key", a: "a0", b: "", want: "a1"},
+		{name: "after the largest negative key", a: "Zz", b: "", want: "a0"},
+		{name: "after a key which carries", a: "az", b: "", want: "b00"},
+		{name: "after a key which carries to the lower case", a: "e0000Z", b: "", want: "e0000a"},
+		{name: "after a key which carries to a longer integer", a: "bzz", b: "", want: "c000"},
+		{name: "between consecutive integers", a: "a0", b: "a1", want: "a0V"},
+		{name: "between an integer and a fraction", a: "a0", b: "a0V", want: "a0G"},
+		{name: "between close fractions", a: "a0", b: "a0G", want: "a08"},
+		{name: "between keys with a gap in the integer", a: "a0", b: "a1V", want: "a1"},
+		{name: "between a negative and a positive key", a: "Zz", b: "a01", want: "a0"},
+		{name: "between keys with a common prefix", a: "b125", b: "b129", want: "b127"},
+		{name: "same key", a: "a0", b: "a0", wantErr: true},
+		{name: "reversed keys", a: "a1", b: "a0", wantErr: true},
+		{name: "invalid lower key", a: "a10", b: "", wantErr: true},
+		{name: "invalid upper key", a: "", b: "a-", wantErr: true},
+		{name: "before a fraction of the smallest integer", a: "", b: "A00000000000000000000000000V", want: "A00000000000000000000000000G"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Between(%q, %q) error = %v, wantErr %v", tt.a, tt.b, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Between(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBetweenKeepsOrder(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	keys := []string{}
+	for i := 0; i < 5000; i++ {
+		j := r.Intn(len(keys) + 1)
+
+		a, b := "", ""
+		if j > 0 {
+			a = keys[j-1]
+		}
+		if j < len(keys) {
+			b = keys[j]
+		}
+
+		key, err := Between(a, b)
+		if err != nil {
+			t.Fatalf("Between(%q, %q) error = %v", a, b, err)
+		}
+
+		if err := Validate(key); err != nil {
+			t.Fatalf("Between(%q, %q) = %q is invalid: %v", a, b, key, err)
+		}
+
+		keys = append(keys[:j], append([]string{key}, keys[j:]...)...)
+	}
+
+	if !sort.StringsAreSorted(keys) {
+		t.Error("keys are not sorted")
+	}
+}
+
+func TestBetweenLength(t *testing.T) {
+	// Appending at the end only increments the integer part
+	key := First
+	for i := 0; i < 100000; i++ {
+		next, err := Between(key, "")
+		if err != nil {
+			t.Fatalf("Between(%q, \"\") error = %v", key, err)
+		}
+		key = next
+	}
+
+	if len(key) > 4 {
+		t.Errorf("key after 100000 appends = %q, want at most 4 characters", key)
+	}
+
+	// Inserting into the same gap grows the key until it is rejected
+	b := "a1"
+	for i := 0; ; i++ {
+		key, err := Between("a0", b)
+		if err != nil {
+			break
+		}
+
+		if len(key) > MaxLength {
+			t.Fatalf("key %q is longer than %d", key, MaxLength)
+		}
+
+		if i > 10000 {
+			t.Fatal("key never reached the maximum length")
+		}
+		b = key
+	}
+}
+
+func TestSequence(t *testing.T) {
+	keys, err := Sequence(5000)
+	if err != nil {
+		t.Fatalf("Sequence() error = %v", err)
+	}
+
+	if len(keys) != 5000 {
+		t.Fatalf("len(Sequence()) = %d, want 5000", len(keys))
+	}
+
+	if keys[0] != First {
+		t.Errorf("first key = %q, want %q", keys[0], First)
+	}
+
+	for i, key := range keys {
+		if err := Validate(key); err != nil {
+			t.Errorf("key %d = %q is invalid: %v", i, key, err)
+		}
+
+		if i > 0 && keys[i-1] >= key {
+			t.Errorf("key %d = %q is not after %q", i, key, keys[i-1])
+		}
+	}
+
+	if last := keys[len(keys)-1]; len(last) > 4 {
+		t.Errorf("last key = %q, want at most 4 characters", last)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{name: "first key", key: "a0"},
+		{name: "key with fraction", key: "a0V"},
+		{name: "negative key", key: "Zz"},
+		{name: "backfilled key", key: "f000001"},
+		{name: "empty", key: "", wantErr: true},
+		{name: "integer part too short", key: "b0", wantErr: true},
+		{name: "invalid head", key: "-0", wantErr: true},
+		{name: "invalid character", key: "a-", wantErr: true},
+		{name: "fraction ends with zero", key: "a10", wantErr: true},
+		{name: "smallest integer", key: smallestInteger, wantErr: true},
+		{name: "too long", key: "a0" + strings.Repeat("V", MaxLength), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.key); (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
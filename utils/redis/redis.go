@@ -0,0 +1,40 @@
+package redis
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/adiatma85/own-go-sdk/log"
+	sdkRedis "github.com/adiatma85/own-go-sdk/redis"
+	"github.com/go-redis/redis/v8"
+)
+
+// Init connect to redis with the same options as the sdk redis. The sdk interface only exposes the string keys, so the
+// client is shared by the domains which need the other data types, e.g. the sorted sets of the leaderboards. Unlike
+// the sdk the service still starts when redis is down, only the features backed by redis fail
+func Init(cfg sdkRedis.Config, log log.Interface) *redis.Client {
+	opts := redis.Options{
+		Network:  cfg.Protocol,
+		Addr:     fmt.Sprintf("%s:%s", cfg.Host, cfg.Port),
+		Username: cfg.Username,
+		Password: cfg.Password,
+	}
+
+	if cfg.TLS.Enabled {
+		opts.TLSConfig = &tls.Config{
+			InsecureSkipVerify: cfg.TLS.InsecureSkipVerify,
+		}
+	}
+
+	rdb := redis.NewClient(&opts)
+
+	ctx := context.Background()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		log.Error(ctx, fmt.Sprintf("cannot connect to redis on address @%s, the features backed by redis are unavailable: %v", opts.Addr, err))
+	} else {
+		log.Info(ctx, fmt.Sprintf("REDIS: Address @%s", opts.Addr))
+	}
+
+	return rdb
+}
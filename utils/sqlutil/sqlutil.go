@@ -0,0 +1,64 @@
+// Package sqlutil hold the helpers shared by the SQL of the domains
+package sqlutil
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL error number of the duplicate entry on a unique index
+const mysqlErrDuplicateEntry = 1062
+
+// IsDuplicateEntry return true when the error is a violation of a unique index, when keys are given the violated
+// index must be one of them
+func IsDuplicateEntry(err error, keys ...string) bool {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlErrDuplicateEntry {
+		return false
+	}
+
+	if len(keys) < 1 {
+		return true
+	}
+
+	key := duplicateKey(mysqlErr.Message)
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// duplicateKey return the index name of the duplicate entry message, e.g. Duplicate entry '1-2' for key
+// 'task.uk_task_fk_series_id_due_time', older MySQL does not prefix the index with its table
+func duplicateKey(message string) string {
+	const forKey = "for key '"
+
+	i := strings.LastIndex(message, forKey)
+	if i < 0 {
+		return ""
+	}
+
+	key := strings.TrimSuffix(message[i+len(forKey):], "'")
+	if j := strings.LastIndex(key, "."); j >= 0 {
+		key = key[j+1:]
+	}
+
+	return key
+}
+
+// JoinIDs return the ids as the comma separated list of an IN condition, the ids are numbers so they are safe to be
+// written into the query
+func JoinIDs(ids []int64) string {
+	values := []string{}
+	for _, id := range ids {
+		values = append(values, strconv.FormatInt(id, 10))
+	}
+
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,37 @@
+package sqlutil
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestIsDuplicateEntry(t *testing.T) {
+	duplicate := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1-2026-10-18 09:00:00' for key 'task.uk_task_fk_series_id_due_time'"}
+
+	tests := []struct {
+		name string
+		err  error
+		keys []string
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "other error", err: errors.New("Duplicate entry"), want: false},
+		{name: "other mysql error", err: &mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row"}, want: false},
+		{name: "any key", err: duplicate, want: true},
+		{name: "wrapped", err: fmt.Errorf("insert task: %w", duplicate), want: true},
+		{name: "matching key", err: duplicate, keys: []string{"uk_task_fk_series_id_due_time"}, want: true},
+		{name: "other key", err: duplicate, keys: []string{"idx_time_entry_running_user_id"}, want: false},
+		{name: "key without table", err: &mysql.MySQLError{Number: 1062, Message: "Duplicate entry '7' for key 'idx_time_entry_running_user_id'"}, keys: []string{"idx_time_entry_running_user_id"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDuplicateEntry(tt.err, tt.keys...); got != tt.want {
+				t.Errorf("IsDuplicateEntry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}